@@ -0,0 +1,134 @@
+package rut
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// maxAuditSamples is the number of invalid values kept in an AuditReport.
+const maxAuditSamples = 100
+
+// AuditReport describes the RUTs stored in a database column, see
+// AuditColumn.
+type AuditReport struct {
+	Rows    int // Rows scanned
+	Empty   int // NULL, empty or blank values
+	Valid   int // Values holding a valid RUT
+	Invalid int // Other values
+
+	// InvalidSamples holds the first invalid values found, up to 100.
+	InvalidSamples []string
+
+	// Duplicates counts the RUTs stored more than once, possibly in
+	// different formats, with the number of extra occurrences.
+	Duplicates map[RUT]int
+
+	// Styles counts the valid values by the style they are written in,
+	// see DetectStyle. Values mixing separators are counted in
+	// UnknownStyle.
+	Styles       map[FormatStyle]int
+	UnknownStyle int
+}
+
+// MixedFormats reports whether valid values are written in more than one
+// style.
+func (a *AuditReport) MixedFormats() bool {
+	n := len(a.Styles)
+	if a.UnknownStyle > 0 {
+		n++
+	}
+	return n > 1
+}
+
+// AuditColumn streams every value of column in table and reports invalid
+// values, duplicates after normalization and mixed formats, the first step
+// before migrating a column. table and column are inserted in the query as
+// is and must be quoted by the caller if needed. Text and integer columns
+// are supported; like RUT.Scan, integers hold the number alone and are
+// counted as FormatNumberOnly.
+func AuditColumn(ctx context.Context, db *sql.DB, table, column string) (*AuditReport, error) {
+	rows, err := db.QueryContext(ctx, "SELECT "+column+" FROM "+table)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	a := &AuditReport{
+		Duplicates: make(map[RUT]int),
+		Styles:     make(map[FormatStyle]int),
+	}
+	seen := NewSparseSet()
+	for rows.Next() {
+		var v any
+		if err := rows.Scan(&v); err != nil {
+			return nil, err
+		}
+		switch v := v.(type) {
+		case nil:
+			a.add("", seen)
+		case string:
+			a.add(v, seen)
+		case []byte:
+			a.add(string(v), seen)
+		case int64:
+			a.addNumber(v, seen)
+		default:
+			return nil, fmt.Errorf("rut: cannot audit %T values", v)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func (a *AuditReport) add(v string, seen *SparseSet) {
+	a.Rows++
+	if normalizeSeparators(v) == "" {
+		a.Empty++
+		return
+	}
+
+	r, err := Parse(v)
+	if err != nil || !r.Validate() {
+		a.invalid(v)
+		return
+	}
+
+	a.valid(r, seen)
+	style, err := DetectStyle(v)
+	if errors.Is(err, ErrUnknownStyle) {
+		a.UnknownStyle++
+		return
+	}
+	a.Styles[style]++
+}
+
+// addNumber records an integer value, which holds the number alone.
+func (a *AuditReport) addNumber(n int64, seen *SparseSet) {
+	a.Rows++
+	var r RUT
+	if err := r.unmarshalNumber(n); err != nil {
+		a.invalid(strconv.FormatInt(n, 10))
+		return
+	}
+	a.valid(r, seen)
+	a.Styles[FormatNumberOnly]++
+}
+
+func (a *AuditReport) valid(r RUT, seen *SparseSet) {
+	a.Valid++
+	if !seen.Add(r) {
+		a.Duplicates[r]++
+	}
+}
+
+func (a *AuditReport) invalid(v string) {
+	a.Invalid++
+	if len(a.InvalidSamples) < maxAuditSamples {
+		a.InvalidSamples = append(a.InvalidSamples, v)
+	}
+}
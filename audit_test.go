@@ -0,0 +1,116 @@
+package rut
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"slices"
+	"testing"
+)
+
+// auditDriver is a database/sql driver whose queries return auditValues
+// as a single column.
+type auditDriver struct{}
+
+var auditValues []driver.Value
+
+func init() {
+	sql.Register("rut-audit-test", auditDriver{})
+}
+
+func (auditDriver) Open(string) (driver.Conn, error) { return auditConn{}, nil }
+
+type auditConn struct{}
+
+func (auditConn) Prepare(string) (driver.Stmt, error) { return auditStmt{}, nil }
+func (auditConn) Close() error                        { return nil }
+func (auditConn) Begin() (driver.Tx, error)           { return nil, driver.ErrSkip }
+
+type auditStmt struct{}
+
+func (auditStmt) Close() error                               { return nil }
+func (auditStmt) NumInput() int                              { return 0 }
+func (auditStmt) Exec([]driver.Value) (driver.Result, error) { return nil, driver.ErrSkip }
+
+func (auditStmt) Query([]driver.Value) (driver.Rows, error) {
+	return &auditRows{values: auditValues}, nil
+}
+
+type auditRows struct {
+	values []driver.Value
+}
+
+func (*auditRows) Columns() []string { return []string{"rut"} }
+func (*auditRows) Close() error      { return nil }
+
+func (r *auditRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	dest[0], r.values = r.values[0], r.values[1:]
+	return nil
+}
+
+func TestAuditColumn(t *testing.T) {
+	auditValues = []driver.Value{
+		"12.345.678-5",
+		"12345678-5",
+		"1.009-K",
+		"1.009-K",
+		int64(1009),
+		"60803000-K",
+		"1234.5678-5",
+		"12.345.678-0",
+		nil,
+		" ",
+		int64(12345678),
+		int64(0),
+	}
+
+	db, err := sql.Open("rut-audit-test", "")
+	if err != nil {
+		t.Fatalf("sql.Open() error = %v", err)
+	}
+	defer db.Close()
+
+	a, err := AuditColumn(context.Background(), db, "customers", "rut")
+	if err != nil {
+		t.Fatalf("AuditColumn() error = %v", err)
+	}
+
+	if a.Rows != 12 || a.Empty != 2 || a.Valid != 8 || a.Invalid != 2 {
+		t.Errorf("AuditColumn() counts = %d rows, %d empty, %d valid, %d invalid; want 12, 2, 8, 2",
+			a.Rows, a.Empty, a.Valid, a.Invalid)
+	}
+	if !slices.Equal(a.InvalidSamples, []string{"12.345.678-0", "0"}) {
+		t.Errorf("AuditColumn() InvalidSamples = %q; want [12.345.678-0 0]", a.InvalidSamples)
+	}
+
+	wantDup := map[RUT]int{MustParse("12.345.678-5"): 3, MustParse("1.009-K"): 2}
+	if len(a.Duplicates) != len(wantDup) {
+		t.Errorf("AuditColumn() Duplicates = %v; want %v", a.Duplicates, wantDup)
+	}
+	for r, n := range wantDup {
+		if a.Duplicates[r] != n {
+			t.Errorf("AuditColumn() Duplicates[%v] = %d; want %d", r, a.Duplicates[r], n)
+		}
+	}
+
+	if a.Styles[FormatComplete] != 3 || a.Styles[FormatWithDash] != 2 || a.Styles[FormatNumberOnly] != 2 || a.UnknownStyle != 1 || !a.MixedFormats() {
+		t.Errorf("AuditColumn() Styles = %v, unknown %d; want 3 complete, 2 with dash, 2 number only, 1 unknown", a.Styles, a.UnknownStyle)
+	}
+}
+
+func TestAuditColumn_UnsupportedType(t *testing.T) {
+	auditValues = []driver.Value{1.5}
+	db, err := sql.Open("rut-audit-test", "")
+	if err != nil {
+		t.Fatalf("sql.Open() error = %v", err)
+	}
+	defer db.Close()
+
+	if _, err := AuditColumn(context.Background(), db, "customers", "rut"); err == nil {
+		t.Error("AuditColumn() on a float column = nil; want error")
+	}
+}
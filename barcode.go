@@ -0,0 +1,96 @@
+package rut
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrBarcodeChecksum is returned by ParseCode39 when the Code 39 check
+// character does not match.
+var ErrBarcodeChecksum = errors.New("rut: barcode check character mismatch")
+
+// code39Chars are the Code 39 characters in the order of their value for
+// the modulo 43 check character.
+const code39Chars = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ-. $/+%"
+
+// Code39Payload returns r as the text of a Code 39 barcode, "12345678-K".
+// Code 39 has no lowercase letters, so the check digit is uppercased. With
+// checksum, the modulo 43 check character that many warehouse systems
+// require is appended. The '*' start and stop characters are added by the
+// barcode renderer, not here. See barcodeRUT for the errors.
+func Code39Payload(r RUT, checksum bool) (string, error) {
+	r, err := barcodeRUT(r)
+	if err != nil {
+		return "", err
+	}
+	s := r.Format(FormatWithDash)
+	if checksum {
+		sum := code39Sum(s)
+		if sum < 0 {
+			return "", ErrInvalidFormat
+		}
+		s += string(code39Chars[sum])
+	}
+	return s, nil
+}
+
+// Code128Payload returns r as the text of a Code 128 barcode, "12345678K".
+// Without separators, renderers pack digit pairs in code set C, giving the
+// narrowest barcode. The check digit is uppercased. See barcodeRUT for the
+// errors.
+func Code128Payload(r RUT) (string, error) {
+	r, err := barcodeRUT(r)
+	if err != nil {
+		return "", err
+	}
+	return r.Format(FormatEscaped), nil
+}
+
+// barcodeRUT returns r with an uppercase check digit. It returns
+// ErrOutOfRange for numbers below 1, including the zero RUT, and
+// ErrInvalidDV if the check digit is missing or does not match, so no
+// barcode is printed for a RUT that cannot be read back.
+func barcodeRUT(r RUT) (RUT, error) {
+	if r.Number < 1 {
+		return RUT{}, ErrOutOfRange
+	}
+	r.DV = upperDV(r.DV)
+	if !r.Validate() {
+		return RUT{}, ErrInvalidDV
+	}
+	return r, nil
+}
+
+// ParseCode39 parses the text read from a Code 39 barcode, with or without
+// the '*' start and stop characters. With checksum, the last character is
+// verified as the modulo 43 check character, returning ErrBarcodeChecksum
+// if it does not match, and removed. The check digit of the RUT is
+// verified too.
+func ParseCode39(s string, checksum bool) (RUT, error) {
+	s = strings.TrimSuffix(strings.TrimPrefix(s, "*"), "*")
+	if checksum {
+		if s == "" {
+			return RUT{}, ErrBarcodeChecksum
+		}
+		data, check := s[:len(s)-1], s[len(s)-1]
+		if sum := code39Sum(data); sum < 0 || code39Chars[sum] != check {
+			return RUT{}, ErrBarcodeChecksum
+		}
+		s = data
+	}
+	return ParseStrict(s)
+}
+
+// code39Sum returns the value of the modulo 43 check character of s, or -1
+// if s has characters outside Code 39.
+func code39Sum(s string) int {
+	sum := 0
+	for i := 0; i < len(s); i++ {
+		v := strings.IndexByte(code39Chars, s[i])
+		if v < 0 {
+			return -1
+		}
+		sum += v
+	}
+	return sum % 43
+}
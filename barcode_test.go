@@ -0,0 +1,103 @@
+package rut
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCode39Payload(t *testing.T) {
+	tests := []struct {
+		r        RUT
+		checksum bool
+		want     string
+	}{
+		{RUT{Number: 12_345_678, DV: '5'}, false, "12345678-5"},
+		{RUT{Number: 12_345_678, DV: '5'}, true, "12345678-5Y"},
+		{RUT{Number: 1009, DV: 'k'}, false, "1009-K"},
+		{RUT{Number: 1009, DV: 'K'}, true, "1009-KN"},
+		{RUT{Number: 60_803_000, DV: 'K'}, true, "60803000-KU"},
+	}
+
+	for _, tt := range tests {
+		if got, err := Code39Payload(tt.r, tt.checksum); err != nil || got != tt.want {
+			t.Errorf("Code39Payload(%v, %v) = %q, %v; want %q, nil", tt.r, tt.checksum, got, err, tt.want)
+		}
+	}
+}
+
+func TestBarcodePayload_Errors(t *testing.T) {
+	tests := []struct {
+		r   RUT
+		err error
+	}{
+		{RUT{}, ErrOutOfRange},
+		{RUT{Number: 0, DV: '0'}, ErrOutOfRange},
+		{RUT{Number: -1_009, DV: 'K'}, ErrOutOfRange},
+		{RUT{Number: 12_345_678}, ErrInvalidDV},
+		{RUT{Number: 12_345_678, DV: '4'}, ErrInvalidDV},
+		{RUT{Number: 12_345_678, DV: '*'}, ErrInvalidDV},
+	}
+
+	for _, tt := range tests {
+		for _, checksum := range []bool{false, true} {
+			if got, err := Code39Payload(tt.r, checksum); got != "" || !errors.Is(err, tt.err) {
+				t.Errorf("Code39Payload(%+v, %v) = %q, %v; want \"\", %v", tt.r, checksum, got, err, tt.err)
+			}
+		}
+		if got, err := Code128Payload(tt.r); got != "" || !errors.Is(err, tt.err) {
+			t.Errorf("Code128Payload(%+v) = %q, %v; want \"\", %v", tt.r, got, err, tt.err)
+		}
+	}
+}
+
+func TestCode128Payload(t *testing.T) {
+	tests := []struct {
+		r    RUT
+		want string
+	}{
+		{RUT{Number: 12_345_678, DV: '5'}, "123456785"},
+		{RUT{Number: 1009, DV: 'k'}, "1009K"},
+	}
+
+	for _, tt := range tests {
+		if got, err := Code128Payload(tt.r); err != nil || got != tt.want {
+			t.Errorf("Code128Payload(%v) = %q, %v; want %q, nil", tt.r, got, err, tt.want)
+		}
+	}
+}
+
+func TestParseCode39(t *testing.T) {
+	tests := []struct {
+		in       string
+		checksum bool
+		want     RUT
+		err      error
+	}{
+		{"12345678-5", false, RUT{Number: 12_345_678, DV: '5'}, nil},
+		{"*12345678-5*", false, RUT{Number: 12_345_678, DV: '5'}, nil},
+		{"12345678-5Y", true, RUT{Number: 12_345_678, DV: '5'}, nil},
+		{"*1009-KN*", true, RUT{Number: 1009, DV: 'K'}, nil},
+		{"12345678-5Z", true, RUT{}, ErrBarcodeChecksum},
+		{"12345678-5", true, RUT{}, ErrBarcodeChecksum},
+		{"1234567a-5", true, RUT{}, ErrBarcodeChecksum},
+		{"**", true, RUT{}, ErrBarcodeChecksum},
+		{"12345678-6", false, RUT{}, ErrInvalidDV},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseCode39(tt.in, tt.checksum)
+		if got != tt.want || !errors.Is(err, tt.err) {
+			t.Errorf("ParseCode39(%q, %v) = %v, %v; want %v, %v", tt.in, tt.checksum, got, err, tt.want, tt.err)
+		}
+	}
+}
+
+func TestParseCode39_RoundTrip(t *testing.T) {
+	for _, r := range []RUT{{Number: 76_086_428, DV: '5'}, {Number: 1009, DV: 'K'}} {
+		s, _ := Code39Payload(r, true)
+		got, err := ParseCode39(s, true)
+		if err != nil || got != r {
+			t.Errorf("ParseCode39(Code39Payload(%v, true), true) = %v, %v; want %v, nil", r, got, err, r)
+		}
+	}
+}
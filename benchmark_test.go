@@ -10,12 +10,26 @@ func BenchmarkValidate(b *testing.B) {
 	}
 }
 
+func BenchmarkValidateBytes(b *testing.B) {
+	input := []byte("12.345.678-5")
+	for i := 0; i < b.N; i++ {
+		ValidateBytes(input)
+	}
+}
+
 func BenchmarkParse(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		Parse("123456785")
 	}
 }
 
+func BenchmarkParseBytes(b *testing.B) {
+	input := []byte("123456785")
+	for i := 0; i < b.N; i++ {
+		ParseBytes(input)
+	}
+}
+
 func BenchmarkFormat_Complete(b *testing.B) {
 	r := RUT{Number: 12345678, DV: '5'}
 	for i := 0; i < b.N; i++ {
@@ -37,8 +51,47 @@ func BenchmarkFormat_WithDash(b *testing.B) {
 	}
 }
 
+func BenchmarkFormat_Spaces(b *testing.B) {
+	r := RUT{Number: 12345678, DV: '5'}
+	for i := 0; i < b.N; i++ {
+		r.Format(FormatSpaces)
+	}
+}
+
+func BenchmarkAppendFormat(b *testing.B) {
+	r := RUT{Number: 12345678, DV: '5'}
+	buf := make([]byte, 0, 16)
+	for i := 0; i < b.N; i++ {
+		buf = r.AppendFormat(buf[:0], FormatComplete)
+	}
+}
+
 func BenchmarkCalculateDV(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		CalculateDV(12345678)
 	}
 }
+
+func BenchmarkRUTSet_Contains(b *testing.B) {
+	s := NewRUTSet(MaxCompanyNumber)
+	for n := 1; n < 30_000_000; n += 7 {
+		s.Add(RUT{Number: n})
+	}
+	r := RUT{Number: 12345678, DV: '5'}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Contains(r)
+	}
+}
+
+func BenchmarkSparseSet_Contains(b *testing.B) {
+	s := NewSparseSet()
+	for n := 1; n < 30_000_000; n += 7919 {
+		s.Add(RUT{Number: n})
+	}
+	r := RUT{Number: 12345678, DV: '5'}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Contains(r)
+	}
+}
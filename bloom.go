@@ -0,0 +1,156 @@
+package rut
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+)
+
+// ErrInvalidEncoding is returned when decoding data that is corrupt or was
+// written in an unsupported format version.
+var ErrInvalidEncoding = errors.New("rut: invalid or unsupported encoding")
+
+// bloomMagic identifies a serialized BloomFilter, followed by a version byte.
+const (
+	bloomMagic   = "RUTB"
+	bloomVersion = 1
+)
+
+// BloomFilter is a probabilistic set of RUT numbers. Contains never misses
+// an added RUT but may report RUTs that were not added, at a configurable
+// false positive rate. A filter for one million RUTs at 1% uses about
+// 1.2 MB, a fraction of a RUTSet.
+//
+// A BloomFilter is safe for concurrent reads but not for concurrent
+// modification.
+type BloomFilter struct {
+	bits []uint64
+	m    uint64 // Number of bits
+	k    uint32 // Number of hash functions
+}
+
+// NewBloomFilter returns a filter sized to hold n RUTs with the given false
+// positive rate, such as 0.01 for 1%.
+func NewBloomFilter(n int, fpRate float64) *BloomFilter {
+	if n < 1 {
+		n = 1
+	}
+	if fpRate <= 0 || fpRate >= 1 {
+		fpRate = 0.01
+	}
+	m := math.Ceil(-float64(n) * math.Log(fpRate) / (math.Ln2 * math.Ln2))
+	k := math.Round(m / float64(n) * math.Ln2)
+	return newBloomFilter(uint64(m), uint32(max(1, min(k, 30))))
+}
+
+func newBloomFilter(m uint64, k uint32) *BloomFilter {
+	words := (m + 63) / 64
+	return &BloomFilter{
+		bits: make([]uint64, words),
+		m:    words * 64,
+		k:    k,
+	}
+}
+
+// Add adds r to the filter. Check digits are not stored.
+func (f *BloomFilter) Add(r RUT) {
+	h1, h2 := bloomHash(r.Number)
+	for i := uint32(0); i < f.k; i++ {
+		bit := (h1 + uint64(i)*h2) % f.m
+		f.bits[bit>>6] |= uint64(1) << (bit & 63)
+	}
+}
+
+// Contains reports whether the number of r may have been added. A false
+// result is definite; a true result is wrong at the false positive rate.
+func (f *BloomFilter) Contains(r RUT) bool {
+	h1, h2 := bloomHash(r.Number)
+	for i := uint32(0); i < f.k; i++ {
+		bit := (h1 + uint64(i)*h2) % f.m
+		if f.bits[bit>>6]&(uint64(1)<<(bit&63)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// bloomHash derives the two hashes combined to produce the k bit positions.
+func bloomHash(number int) (uint64, uint64) {
+	h1 := mix64(uint64(number))
+	return h1, mix64(h1) | 1
+}
+
+// mix64 is the splitmix64 finalizer.
+func mix64(x uint64) uint64 {
+	x += 0x9e3779b97f4a7c15
+	x = (x ^ (x >> 30)) * 0xbf58476d1ce4e5b9
+	x = (x ^ (x >> 27)) * 0x94d049bb133111eb
+	return x ^ (x >> 31)
+}
+
+// WriteTo implements io.WriterTo. The encoding is a 4 byte magic "RUTB", a
+// version byte, the number of hash functions (uint32), the number of bits
+// (uint64) and the bitset, all little endian.
+func (f *BloomFilter) WriteTo(w io.Writer) (int64, error) {
+	bw := bufio.NewWriter(w)
+	var header [17]byte
+	copy(header[:], bloomMagic)
+	header[4] = bloomVersion
+	binary.LittleEndian.PutUint32(header[5:], f.k)
+	binary.LittleEndian.PutUint64(header[9:], f.m)
+	bw.Write(header[:])
+
+	var word [8]byte
+	for _, v := range f.bits {
+		binary.LittleEndian.PutUint64(word[:], v)
+		bw.Write(word[:])
+	}
+	if err := bw.Flush(); err != nil {
+		return 0, err
+	}
+	return int64(len(header) + 8*len(f.bits)), nil
+}
+
+// ReadBloomFilter decodes a filter written by WriteTo. It returns
+// ErrInvalidEncoding if the data is not a filter of a supported version.
+// It reads exactly the bytes of the filter, so data following it in r is
+// left for the caller, and allocates memory as the bitset is read rather
+// than trusting the size in the header.
+func ReadBloomFilter(r io.Reader) (*BloomFilter, error) {
+	var header [17]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return nil, headerError(err)
+	}
+	if string(header[:4]) != bloomMagic || header[4] != bloomVersion {
+		return nil, ErrInvalidEncoding
+	}
+	k := binary.LittleEndian.Uint32(header[5:])
+	m := binary.LittleEndian.Uint64(header[9:])
+	if k == 0 || k > 30 || m == 0 || m%64 != 0 || m > 1<<36 {
+		return nil, ErrInvalidEncoding
+	}
+
+	var buf [8 << 10]byte
+	words := m / 64
+	bits := make([]uint64, 0, min(words, uint64(len(buf)/8)))
+	for uint64(len(bits)) < words {
+		chunk := buf[:8*min(words-uint64(len(bits)), uint64(len(buf)/8))]
+		if _, err := io.ReadFull(r, chunk); err != nil {
+			return nil, headerError(err)
+		}
+		for i := 0; i < len(chunk); i += 8 {
+			bits = append(bits, binary.LittleEndian.Uint64(chunk[i:]))
+		}
+	}
+	return &BloomFilter{bits: bits, m: m, k: k}, nil
+}
+
+// headerError reports truncated input as ErrInvalidEncoding.
+func headerError(err error) error {
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return ErrInvalidEncoding
+	}
+	return err
+}
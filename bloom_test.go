@@ -0,0 +1,98 @@
+package rut
+
+import (
+	"bytes"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestBloomFilter(t *testing.T) {
+	const n = 10000
+	f := NewBloomFilter(n, 0.01)
+	for i := 0; i < n; i++ {
+		f.Add(RUT{Number: 10_000_000 + i*37})
+	}
+
+	for i := 0; i < n; i++ {
+		if r := (RUT{Number: 10_000_000 + i*37}); !f.Contains(r) {
+			t.Fatalf("Contains(%d) = false for an added RUT", r.Number)
+		}
+	}
+
+	falsePositives := 0
+	for i := 0; i < n; i++ {
+		if f.Contains(RUT{Number: 50_000_000 + i}) {
+			falsePositives++
+		}
+	}
+	if rate := float64(falsePositives) / n; rate > 0.02 {
+		t.Errorf("false positive rate = %.4f; want <= 0.02", rate)
+	}
+}
+
+func TestBloomFilter_WriteTo(t *testing.T) {
+	f := NewBloomFilter(1000, 0.001)
+	f.Add(MustParse("12.345.678-5"))
+	f.Add(MustParse("1.009-K"))
+
+	var buf bytes.Buffer
+	n, err := f.WriteTo(&buf)
+	if err != nil {
+		t.Fatalf("WriteTo() error = %v", err)
+	}
+	if n != int64(buf.Len()) {
+		t.Errorf("WriteTo() = %d; want %d bytes written", n, buf.Len())
+	}
+
+	got, err := ReadBloomFilter(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("ReadBloomFilter() error = %v", err)
+	}
+	if !got.Contains(MustParse("12.345.678-5")) || !got.Contains(MustParse("1.009-K")) {
+		t.Error("decoded filter is missing added RUTs")
+	}
+	if got.k != f.k || got.m != f.m {
+		t.Errorf("decoded k, m = %d, %d; want %d, %d", got.k, got.m, f.k, f.m)
+	}
+}
+
+func TestReadBloomFilter_Invalid(t *testing.T) {
+	var buf bytes.Buffer
+	NewBloomFilter(100, 0.01).WriteTo(&buf)
+	data := buf.Bytes()
+
+	tests := map[string][]byte{
+		"empty":     nil,
+		"magic":     append([]byte("XXXX"), data[4:]...),
+		"version":   append(append([]byte(bloomMagic), 9), data[5:]...),
+		"truncated": data[:len(data)-1],
+		"huge":      append([]byte(bloomMagic), 1, 7, 0, 0, 0, 0, 0, 0, 0, 16, 0, 0, 0, 0xff), // 1<<36 bits, one byte of data
+	}
+	for name, input := range tests {
+		if _, err := ReadBloomFilter(bytes.NewReader(input)); !errors.Is(err, ErrInvalidEncoding) {
+			t.Errorf("%s: ReadBloomFilter() error = %v; want %v", name, err, ErrInvalidEncoding)
+		}
+	}
+}
+
+func TestReadBloomFilter_TrailingData(t *testing.T) {
+	f := NewBloomFilter(100_000, 0.01) // Larger than one read chunk
+	for n := 1_000_000; n < 1_000_500; n++ {
+		f.Add(RUT{Number: n, DV: CalculateDV(n)})
+	}
+	var buf bytes.Buffer
+	f.WriteTo(&buf)
+	buf.WriteString("next")
+
+	got, err := ReadBloomFilter(&buf)
+	if err != nil {
+		t.Fatalf("ReadBloomFilter() error = %v", err)
+	}
+	if !slices.Equal(got.bits, f.bits) {
+		t.Error("decoded bitset differs from the encoded one")
+	}
+	if rest := buf.String(); rest != "next" {
+		t.Errorf("ReadBloomFilter() left %q; want %q", rest, "next")
+	}
+}
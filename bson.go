@@ -0,0 +1,95 @@
+package rut
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// BSON type bytes, see https://bsonspec.org/spec.html.
+const (
+	bsonString = 0x02
+	bsonNull   = 0x0a
+	bsonInt32  = 0x10
+	bsonInt64  = 0x12
+)
+
+// MarshalBSONValue implements bson.ValueMarshaler of the MongoDB Go driver
+// v2, storing the RUT as a FormatComplete string. The zero RUT is stored as
+// null. The driver interfaces only use built-in types, so this package does
+// not depend on the driver.
+func (r RUT) MarshalBSONValue() (byte, []byte, error) {
+	if r.IsZero() {
+		return bsonNull, nil, nil
+	}
+	text := r.Format(FormatComplete)
+	data := binary.LittleEndian.AppendUint32(nil, uint32(len(text)+1))
+	data = append(data, text...)
+	return bsonString, append(data, 0), nil
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler of the MongoDB Go
+// driver v2. It accepts strings in any format supported by Parse, returning
+// ErrInvalidDV for a wrong check digit, and int32 or int64 numbers as
+// stored by BSONInt32, whose check digit is computed. Null decodes to the
+// zero RUT.
+func (r *RUT) UnmarshalBSONValue(typ byte, data []byte) error {
+	switch typ {
+	case bsonNull:
+		*r = RUT{}
+		return nil
+	case bsonString:
+		if len(data) < 5 || int(binary.LittleEndian.Uint32(data)) != len(data)-4 || data[len(data)-1] != 0 {
+			return ErrInvalidEncoding
+		}
+		return r.UnmarshalText(data[4 : len(data)-1])
+	case bsonInt32:
+		if len(data) != 4 {
+			return ErrInvalidEncoding
+		}
+		return r.unmarshalNumber(int64(int32(binary.LittleEndian.Uint32(data))))
+	case bsonInt64:
+		if len(data) != 8 {
+			return ErrInvalidEncoding
+		}
+		return r.unmarshalNumber(int64(binary.LittleEndian.Uint64(data)))
+	}
+	return ErrInvalidEncoding
+}
+
+func (r *RUT) unmarshalNumber(n int64) error {
+	if n <= 0 || n > MaxSetNumber {
+		return ErrInvalidFormat
+	}
+	*r = Unpack(uint32(n))
+	return nil
+}
+
+// BSONInt32 stores a RUT in MongoDB as an int32 holding the number alone,
+// which is smaller than a string and sorts numerically. Convert struct
+// fields to it to opt in:
+//
+//	type Customer struct {
+//		RUT rut.BSONInt32 `bson:"rut"`
+//	}
+//
+// Only valid RUTs round-trip, since the check digit is recomputed on
+// decode. Strings are accepted on decode, for collections being migrated.
+type BSONInt32 RUT
+
+// MarshalBSONValue implements bson.ValueMarshaler of the MongoDB Go driver
+// v2. The zero RUT is stored as null.
+func (b BSONInt32) MarshalBSONValue() (byte, []byte, error) {
+	if b == (BSONInt32{}) {
+		return bsonNull, nil, nil
+	}
+	if b.Number <= 0 || b.Number > math.MaxInt32 {
+		return 0, nil, ErrInvalidFormat
+	}
+	return bsonInt32, binary.LittleEndian.AppendUint32(nil, uint32(b.Number)), nil
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler of the MongoDB Go
+// driver v2, accepting the same values as RUT.UnmarshalBSONValue.
+func (b *BSONInt32) UnmarshalBSONValue(typ byte, data []byte) error {
+	return (*RUT)(b).UnmarshalBSONValue(typ, data)
+}
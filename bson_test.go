@@ -0,0 +1,56 @@
+package rut
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestRUT_MarshalBSONValue(t *testing.T) {
+	typ, data, err := MustParse("1009-k").MarshalBSONValue()
+	want := []byte("\x08\x00\x00\x001.009-K\x00")
+	if err != nil || typ != bsonString || !bytes.Equal(data, want) {
+		t.Errorf("MarshalBSONValue() = %#x, %q, %v; want %#x, %q, nil", typ, data, err, bsonString, want)
+	}
+
+	typ, data, err = BSONInt32(MustParse("12.345.678-5")).MarshalBSONValue()
+	want = []byte{0x4e, 0x61, 0xbc, 0x00}
+	if err != nil || typ != bsonInt32 || !bytes.Equal(data, want) {
+		t.Errorf("BSONInt32.MarshalBSONValue() = %#x, %x, %v; want %#x, %x, nil", typ, data, err, bsonInt32, want)
+	}
+
+	if typ, _, _ := (RUT{}).MarshalBSONValue(); typ != bsonNull {
+		t.Errorf("MarshalBSONValue(zero) type = %#x; want %#x", typ, bsonNull)
+	}
+}
+
+func TestRUT_UnmarshalBSONValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		typ     byte
+		data    []byte
+		want    RUT
+		wantErr error
+	}{
+		{"string", bsonString, []byte("\x0b\x00\x00\x0012345678-5\x00"), MustParse("12.345.678-5"), nil},
+		{"int32", bsonInt32, []byte{0x4e, 0x61, 0xbc, 0x00}, MustParse("12.345.678-5"), nil},
+		{"int64", bsonInt64, []byte{0xf1, 0x03, 0, 0, 0, 0, 0, 0}, MustParse("1.009-K"), nil},
+		{"null", bsonNull, nil, RUT{}, nil},
+		{"wrong dv", bsonString, []byte("\x0b\x00\x00\x0012345678-0\x00"), RUT{}, ErrInvalidDV},
+		{"bad length", bsonString, []byte("\x20\x00\x00\x0012345678-5\x00"), RUT{}, ErrInvalidEncoding},
+		{"negative", bsonInt32, []byte{0xff, 0xff, 0xff, 0xff}, RUT{}, ErrInvalidFormat},
+		{"double", 0x01, make([]byte, 8), RUT{}, ErrInvalidEncoding},
+	}
+
+	for _, tt := range tests {
+		var got BSONInt32
+		err := got.UnmarshalBSONValue(tt.typ, tt.data)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: UnmarshalBSONValue() error = %v; want %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if RUT(got) != tt.want {
+			t.Errorf("%s: UnmarshalBSONValue() = %v; want %v", tt.name, RUT(got), tt.want)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package rut
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"time"
+)
+
+// Cédula errors
+var (
+	ErrInvalidBarcode        = errors.New("rut: invalid cédula barcode")
+	ErrInvalidDocumentNumber = errors.New("rut: invalid cédula document number")
+	ErrDocumentMismatch      = errors.New("rut: cédula data does not match")
+)
+
+// Layout of the PDF417 barcode on the back of cédulas de identidad issued
+// before 2013. The Registro Civil does not publish it; these offsets are
+// the ones observed on cards in circulation.
+const (
+	pdf417RUN      = 0   // 9 bytes, number and check digit
+	pdf417Surname  = 19  // 30 bytes, padded
+	pdf417Country  = 49  // 3 bytes, "CHL"
+	pdf417Expiry   = 52  // 6 bytes, YYMMDD
+	pdf417Document = 109 // 10 bytes, document number
+	pdf417MinLen   = pdf417Document + 10
+)
+
+// CedulaBarcode is the data read from the PDF417 barcode of a cédula.
+type CedulaBarcode struct {
+	RUN            RUT
+	Surname        string    // First surname, as printed on the card
+	Country        string    // Nationality, such as "CHL"
+	Expiry         time.Time // Expiration date of the card
+	DocumentNumber string    // Number of the card, unique per issued document
+}
+
+// ParseCedulaPDF417 decodes the payload of the PDF417 barcode printed on
+// the back of cédulas de identidad issued before 2013, as returned by a
+// barcode scanner, for kiosks that verify IDs offline. The RUN must have a
+// valid check digit. Text fields are decoded as ISO-8859-1 and trimmed.
+//
+// It returns ErrInvalidBarcode if the payload is too short or a field does
+// not have the expected form, or the parse error of the RUN.
+func ParseCedulaPDF417(data []byte) (CedulaBarcode, error) {
+	var c CedulaBarcode
+	if len(data) < pdf417MinLen {
+		return c, ErrInvalidBarcode
+	}
+	field := func(off, n int) string {
+		return latin1String(bytes.Trim(data[off:off+n], " \x00"))
+	}
+
+	run, err := ParseStrict(field(pdf417RUN, 9))
+	if err != nil {
+		return c, err
+	}
+	c.RUN = run
+	c.Surname = field(pdf417Surname, 30)
+	c.Country = field(pdf417Country, 3)
+	c.DocumentNumber = field(pdf417Document, 10)
+
+	if c.Expiry, err = time.Parse("060102", field(pdf417Expiry, 6)); err != nil {
+		return c, ErrInvalidBarcode
+	}
+	if c.DocumentNumber == "" || strings.Trim(c.DocumentNumber, "0123456789") != "" {
+		return c, ErrInvalidBarcode
+	}
+	return c, nil
+}
+
+// latin1String converts ISO-8859-1 bytes to a string.
+func latin1String(b []byte) string {
+	runes := make([]rune, len(b))
+	for i, c := range b {
+		runes[i] = rune(c)
+	}
+	return string(runes)
+}
+
+// CedulaNumber is the number of a cédula de identidad, which identifies the
+// card rather than the person and is asked together with the RUN to check
+// that a document is current. Cards issued since 2013 have a nine digit
+// document number, printed as "100.123.456"; older cards have a serial
+// number made of a letter and nine digits, as in "A012345678".
+type CedulaNumber struct {
+	Value  string // Digits, with the leading letter of serial numbers
+	Serial bool   // A serial number of an older card
+}
+
+// ParseCedulaNumber parses a document or serial number, ignoring dots,
+// spaces and case. It returns ErrInvalidDocumentNumber if s has neither
+// form. The numbers carry no check digit of their own; the MRZ check
+// digits cover them, see MRZ.MatchCedula.
+func ParseCedulaNumber(s string) (CedulaNumber, error) {
+	v := strings.ToUpper(strings.Map(func(c rune) rune {
+		if c == '.' || c == ' ' {
+			return -1
+		}
+		return c
+	}, s))
+
+	var n CedulaNumber
+	switch {
+	case len(v) == 9 && isDigits(v):
+		n.Value = v
+	case len(v) == 10 && v[0] >= 'A' && v[0] <= 'Z' && isDigits(v[1:]):
+		n.Value, n.Serial = v, true
+	default:
+		return n, ErrInvalidDocumentNumber
+	}
+	return n, nil
+}
+
+// String returns a document number with dots, as printed on the card, and
+// a serial number as is.
+func (n CedulaNumber) String() string {
+	if n.Serial || len(n.Value) != 9 {
+		return n.Value
+	}
+	return n.Value[:3] + "." + n.Value[3:6] + "." + n.Value[6:]
+}
+
+// CheckCedula validates the pair of RUN and document number given by a
+// person, as identity-proofing flows require both: the RUN must have a
+// valid check digit and belong to a natural person, and the number must
+// parse with ParseCedulaNumber.
+func CheckCedula(run RUT, number string) (CedulaNumber, error) {
+	if !run.Validate() {
+		return CedulaNumber{}, ErrInvalidDV
+	}
+	if !run.IsPerson() && !run.IsProvisional() {
+		return CedulaNumber{}, ErrNotPerson
+	}
+	return ParseCedulaNumber(number)
+}
+
+// MatchCedula reports ErrDocumentMismatch unless the MRZ of a card holds
+// the given RUN and document number, for checking typed data against a
+// scanned card.
+func (m MRZ) MatchCedula(run RUT, number CedulaNumber) error {
+	if !m.RUN.Equal(run) || m.DocumentNumber != number.Value {
+		return ErrDocumentMismatch
+	}
+	return nil
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
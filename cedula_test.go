@@ -0,0 +1,121 @@
+package rut
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+)
+
+// pdf417Payload builds a barcode payload with the given fields.
+func pdf417Payload(run, surname, country, expiry, doc string) []byte {
+	b := bytes.Repeat([]byte{0}, 420)
+	copy(b[pdf417RUN:], run)
+	copy(b[pdf417Surname:], surname)
+	copy(b[pdf417Country:], country)
+	copy(b[pdf417Expiry:], expiry)
+	copy(b[pdf417Document:], doc)
+	return b
+}
+
+func TestParseCedulaPDF417(t *testing.T) {
+	data := pdf417Payload("123456785", "MU\xd1OZ", "CHL", "250630", "0102030405")
+	got, err := ParseCedulaPDF417(data)
+	want := CedulaBarcode{
+		RUN:            RUT{Number: 12_345_678, DV: '5'},
+		Surname:        "MUÑOZ",
+		Country:        "CHL",
+		Expiry:         time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC),
+		DocumentNumber: "0102030405",
+	}
+	if err != nil || got != want {
+		t.Errorf("ParseCedulaPDF417() = %+v, %v; want %+v, nil", got, err, want)
+	}
+}
+
+func TestParseCedulaPDF417_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		want error
+	}{
+		{"short", []byte("123456785"), ErrInvalidBarcode},
+		{"wrong DV", pdf417Payload("123456780", "PEREZ", "CHL", "250630", "0102030405"), ErrInvalidDV},
+		{"empty RUN", pdf417Payload("", "PEREZ", "CHL", "250630", "0102030405"), ErrEmptyRUT},
+		{"bad expiry", pdf417Payload("1009K", "PEREZ", "CHL", "251399", "0102030405"), ErrInvalidBarcode},
+		{"bad document", pdf417Payload("1009K", "PEREZ", "CHL", "250630", "A102030405"), ErrInvalidBarcode},
+	}
+
+	for _, tt := range tests {
+		if _, err := ParseCedulaPDF417(tt.data); !errors.Is(err, tt.want) {
+			t.Errorf("%s: ParseCedulaPDF417() error = %v; want %v", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestParseCedulaNumber(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    CedulaNumber
+		str     string
+		wantErr error
+	}{
+		{"100.123.456", CedulaNumber{Value: "100123456"}, "100.123.456", nil},
+		{"100123456", CedulaNumber{Value: "100123456"}, "100.123.456", nil},
+		{"a012345678", CedulaNumber{Value: "A012345678", Serial: true}, "A012345678", nil},
+		{"A 012.345.678", CedulaNumber{Value: "A012345678", Serial: true}, "A012345678", nil},
+		{"10012345", CedulaNumber{}, "", ErrInvalidDocumentNumber},
+		{"1001234567", CedulaNumber{}, "", ErrInvalidDocumentNumber},
+		{"AB12345678", CedulaNumber{}, "", ErrInvalidDocumentNumber},
+		{"", CedulaNumber{}, "", ErrInvalidDocumentNumber},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseCedulaNumber(tt.input)
+		if got != tt.want || !errors.Is(err, tt.wantErr) {
+			t.Errorf("ParseCedulaNumber(%q) = %+v, %v; want %+v, %v", tt.input, got, err, tt.want, tt.wantErr)
+		}
+		if err == nil && got.String() != tt.str {
+			t.Errorf("ParseCedulaNumber(%q).String() = %q; want %q", tt.input, got.String(), tt.str)
+		}
+	}
+}
+
+func TestCheckCedula(t *testing.T) {
+	tests := []struct {
+		run    RUT
+		number string
+		want   error
+	}{
+		{RUT{Number: 12_345_678, DV: '5'}, "100.123.456", nil},
+		{RUT{Number: 12_345_678, DV: '0'}, "100.123.456", ErrInvalidDV},
+		{RUT{Number: 60_803_000, DV: 'K'}, "100.123.456", ErrNotPerson},
+		{RUT{Number: 12_345_678, DV: '5'}, "12345", ErrInvalidDocumentNumber},
+	}
+
+	for _, tt := range tests {
+		if _, err := CheckCedula(tt.run, tt.number); !errors.Is(err, tt.want) {
+			t.Errorf("CheckCedula(%v, %q) = %v; want %v", tt.run, tt.number, err, tt.want)
+		}
+	}
+}
+
+func TestMRZ_MatchCedula(t *testing.T) {
+	m, err := ParseMRZ("INCHL1001234562<<<<<<<<<<<<<<<\n8502153F3002153CHL12345678<5<9\nGONZALEZ<MUNOZ<<MARIA<JOSE<<<<")
+	if err != nil {
+		t.Fatal(err)
+	}
+	run := RUT{Number: 12_345_678, DV: '5'}
+	number, _ := ParseCedulaNumber("100.123.456")
+	other, _ := ParseCedulaNumber("100.123.457")
+
+	if err := m.MatchCedula(run, number); err != nil {
+		t.Errorf("MatchCedula() = %v; want nil", err)
+	}
+	if err := m.MatchCedula(run, other); err != ErrDocumentMismatch {
+		t.Errorf("MatchCedula() with another number = %v; want ErrDocumentMismatch", err)
+	}
+	if err := m.MatchCedula(RUT{Number: 1009, DV: 'K'}, number); err != ErrDocumentMismatch {
+		t.Errorf("MatchCedula() with another RUN = %v; want ErrDocumentMismatch", err)
+	}
+}
@@ -0,0 +1,129 @@
+package rut
+
+import "strings"
+
+// Warning flags an input that was accepted but deserves a second look.
+type Warning string
+
+// Warnings reported by Check.
+const (
+	// WarnUnknownStyle: the separators are misplaced or mixed, as in
+	// "1234.5678-5", so DetectStyle fails.
+	WarnUnknownStyle Warning = "unknown_style"
+	// WarnLeadingZeros: the number was written with leading zeros.
+	WarnLeadingZeros Warning = "leading_zeros"
+	// WarnBelowMillion: the number is below 1.000.000, which is rare for
+	// living persons and often a truncated entry.
+	WarnBelowMillion Warning = "below_million"
+	// WarnAboveIssuance: a natural person RUN above PersonIssuanceCeiling,
+	// not yet assigned by the Registro Civil.
+	WarnAboveIssuance Warning = "above_issuance"
+	// WarnReserved: a placeholder RUT, see RUT.IsReserved.
+	WarnReserved Warning = "reserved"
+	// WarnFictitious: a made up looking number, see RUT.IsFictitious.
+	WarnFictitious Warning = "fictitious"
+)
+
+// PersonIssuanceCeiling is the approximate highest RUN assigned to natural
+// persons, other than the foreign investor range, when this version was
+// released. Check warns about person RUTs above it; use a Checker to raise
+// it as new RUNs are issued.
+const PersonIssuanceCeiling = 30_000_000
+
+// Checker is a configurable Check. The zero Checker behaves like Check.
+type Checker struct {
+	// PersonIssuanceCeiling replaces the package constant of the same
+	// name when not zero.
+	PersonIssuanceCeiling int
+}
+
+// ValidationResult gathers everything Check learns about an input, for
+// batch tools and intake flows that would otherwise call Parse,
+// DetectStyle, Validate and the classification methods for every record.
+type ValidationResult struct {
+	Input      string      // The checked input
+	RUT        RUT         // The parsed RUT, the zero RUT if it does not parse
+	Canonical  string      // RUT in FormatComplete style, "" if it does not parse
+	Style      FormatStyle // Style of Input, valid if KnownStyle
+	KnownStyle bool        // Whether DetectStyle recognized the style
+	ValidDV    bool        // Whether the check digit matches
+	Kind       Kind        // Classification by number range
+	Reserved   bool        // See RUT.IsReserved
+	Warnings   []Warning   // Accepted but suspicious aspects of the input
+	Err        error       // Parse error, or ErrInvalidDV for a wrong check digit
+}
+
+// Valid reports whether the input parsed and has a valid check digit.
+// Warnings do not make a result invalid.
+func (v ValidationResult) Valid() bool {
+	return v.Err == nil
+}
+
+// HasWarning reports whether w is among the warnings of the result.
+func (v ValidationResult) HasWarning(w Warning) bool {
+	for _, got := range v.Warnings {
+		if got == w {
+			return true
+		}
+	}
+	return false
+}
+
+// Check parses s and reports its canonical form, input style,
+// classification, check digit and warnings in a single call.
+func Check(s string) ValidationResult {
+	return Checker{}.Check(s)
+}
+
+// Check is like the package Check function, with the settings of c.
+func (c Checker) Check(s string) ValidationResult {
+	res := ValidationResult{Input: s}
+	r, err := Parse(s)
+	if err != nil {
+		res.Err = err
+		return res
+	}
+
+	res.RUT = r
+	res.Canonical = r.Format(FormatComplete)
+	res.Kind = r.Kind()
+	res.Reserved = r.IsReserved()
+	res.ValidDV = r.Validate()
+	if !res.ValidDV {
+		res.Err = dvError(s)
+	}
+
+	trimmed := strings.TrimSpace(s)
+	res.Style, res.KnownStyle = detectStyle(trimmed)
+	if !res.KnownStyle {
+		res.Warnings = append(res.Warnings, WarnUnknownStyle)
+	}
+	if strings.HasPrefix(trimmed, "0") && r.Number != 0 {
+		res.Warnings = append(res.Warnings, WarnLeadingZeros)
+	}
+	res.Warnings = append(res.Warnings, c.suspicious(r)...)
+	return res
+}
+
+// suspicious returns the warnings for numbers that have a valid form but
+// are unlikely to identify a real taxpayer.
+func (c Checker) suspicious(r RUT) []Warning {
+	ceiling := c.PersonIssuanceCeiling
+	if ceiling == 0 {
+		ceiling = PersonIssuanceCeiling
+	}
+	var ws []Warning
+	if r.Number < 1_000_000 {
+		ws = append(ws, WarnBelowMillion)
+	}
+	if r.IsPerson() && !r.IsForeignInvestor() && r.Number > ceiling {
+		ws = append(ws, WarnAboveIssuance)
+	}
+	if r.IsReserved() {
+		ws = append(ws, WarnReserved)
+	}
+	if r.IsFictitious() {
+		ws = append(ws, WarnFictitious)
+	}
+	return ws
+}
@@ -0,0 +1,82 @@
+package rut
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCheck(t *testing.T) {
+	got := Check(" 60803000-k ")
+	want := ValidationResult{
+		Input:      " 60803000-k ",
+		RUT:        RUT{Number: 60803000, DV: 'K'},
+		Canonical:  "60.803.000-K",
+		Style:      FormatWithDash,
+		KnownStyle: true,
+		ValidDV:    true,
+		Kind:       KindCompany,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Check() = %+v; want %+v", got, want)
+	}
+	if !got.Valid() {
+		t.Error("Valid() = false; want true")
+	}
+}
+
+func TestCheck_Results(t *testing.T) {
+	tests := []struct {
+		input    string
+		valid    bool
+		kind     Kind
+		reserved bool
+		warnings []Warning
+		wantErr  error
+	}{
+		{"18.765.432-7", true, KindPerson, false, nil, nil},
+		{"18.765.432-0", false, KindPerson, false, nil, ErrInvalidDV},
+		{"1876.5432-7", true, KindPerson, false, []Warning{WarnUnknownStyle}, nil},
+		{"018.765.432-7", true, KindPerson, false, []Warning{WarnLeadingZeros}, nil},
+		{"018765432-7", true, KindPerson, false, []Warning{WarnLeadingZeros}, nil},
+		{"875.431-4", true, KindPerson, false, []Warning{WarnBelowMillion}, nil},
+		{"41.234.567-3", true, KindPerson, false, []Warning{WarnAboveIssuance}, nil},
+		{"46.123.456-9", true, KindPerson, false, nil, nil},
+		{"12.345.678-5", true, KindPerson, false, []Warning{WarnFictitious}, nil},
+		{"55.555.555-5", true, KindCompany, true, []Warning{WarnReserved, WarnFictitious}, nil},
+		{"abc", false, KindUnknown, false, nil, ErrInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		got := Check(tt.input)
+		if got.Valid() != tt.valid || got.Kind != tt.kind || got.Reserved != tt.reserved ||
+			!reflect.DeepEqual(got.Warnings, tt.warnings) || !errors.Is(got.Err, tt.wantErr) {
+			t.Errorf("Check(%q) = %+v", tt.input, got)
+		}
+	}
+}
+
+func TestValidationResult_HasWarning(t *testing.T) {
+	res := Check("1876.5432-7")
+	if !res.HasWarning(WarnUnknownStyle) || res.HasWarning(WarnLeadingZeros) {
+		t.Errorf("Warnings = %v", res.Warnings)
+	}
+}
+
+func TestChecker_PersonIssuanceCeiling(t *testing.T) {
+	tests := []struct {
+		ceiling int
+		want    bool
+	}{
+		{0, true},
+		{40_000_000, true},
+		{45_000_000, false},
+	}
+
+	for _, tt := range tests {
+		c := Checker{PersonIssuanceCeiling: tt.ceiling}
+		if got := c.Check("41.234.567-3").HasWarning(WarnAboveIssuance); got != tt.want {
+			t.Errorf("Checker{%d}.Check(41.234.567-3) warns above issuance = %v; want %v", tt.ceiling, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package rut
+
+import (
+	"cmp"
+	"slices"
+	"sort"
+)
+
+// Compare returns -1, 0 or +1 depending on whether a sorts before, equal to
+// or after b. RUTs are ordered by number, then by check digit, so Compare
+// can be passed to slices.SortFunc and slices.BinarySearchFunc.
+func Compare(a, b RUT) int {
+	if c := cmp.Compare(a.Number, b.Number); c != 0 {
+		return c
+	}
+	return cmp.Compare(upperDV(a.DV), upperDV(b.DV))
+}
+
+// Compare is like the package level Compare with r as first argument.
+func (r RUT) Compare(o RUT) int {
+	return Compare(r, o)
+}
+
+// Less reports whether r sorts before o.
+func (r RUT) Less(o RUT) bool {
+	return Compare(r, o) < 0
+}
+
+// Equal reports whether r and o have the same number and check digit,
+// ignoring the case of 'K'.
+func (r RUT) Equal(o RUT) bool {
+	return Compare(r, o) == 0
+}
+
+// Next returns the RUT with the following number and its check digit, or
+// the zero RUT after the largest number a Parser accepts with WithLength,
+// 999.999.999.999.999.999 on 64-bit platforms and 999.999.999 on 32-bit
+// ones.
+func (r RUT) Next() RUT {
+	if r.Number < 0 || r.Number >= maxNumber {
+		return RUT{}
+	}
+	return RUT{Number: r.Number + 1, DV: CalculateDV(r.Number + 1)}
+}
+
+// Prev returns the RUT with the preceding number and its check digit, or
+// the zero RUT before 1 or after the largest number a Parser accepts.
+func (r RUT) Prev() RUT {
+	if r.Number <= 1 || r.Number > maxNumber {
+		return RUT{}
+	}
+	return RUT{Number: r.Number - 1, DV: CalculateDV(r.Number - 1)}
+}
+
+func upperDV(dv byte) byte {
+	if dv == 'k' {
+		return 'K'
+	}
+	return dv
+}
+
+// RUTSlice attaches the methods of sort.Interface to []RUT, sorting in
+// increasing order.
+type RUTSlice []RUT
+
+var _ sort.Interface = RUTSlice(nil)
+
+func (x RUTSlice) Len() int           { return len(x) }
+func (x RUTSlice) Less(i, j int) bool { return x[i].Less(x[j]) }
+func (x RUTSlice) Swap(i, j int)      { x[i], x[j] = x[j], x[i] }
+
+// Sort sorts the slice in increasing order.
+func (x RUTSlice) Sort() {
+	slices.SortFunc(x, Compare)
+}
+
+// Search searches for r in a sorted slice and returns the position where
+// it is found, or the position where it would be inserted, and whether it
+// was found.
+func (x RUTSlice) Search(r RUT) (int, bool) {
+	return slices.BinarySearchFunc(x, r, Compare)
+}
@@ -0,0 +1,97 @@
+package rut
+
+import (
+	"slices"
+	"sort"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		a, b     RUT
+		expected int
+	}{
+		{RUT{Number: 1009, DV: 'K'}, RUT{Number: 12345678, DV: '5'}, -1},
+		{RUT{Number: 12345678, DV: '5'}, RUT{Number: 1009, DV: 'K'}, 1},
+		{RUT{Number: 12345678, DV: '5'}, RUT{Number: 12345678, DV: '5'}, 0},
+		{RUT{Number: 12345678, DV: '5'}, RUT{Number: 12345678, DV: '6'}, -1},
+		{RUT{Number: 1009, DV: 'K'}, RUT{Number: 1009, DV: 'k'}, 0},
+		{RUT{Number: 1009, DV: '9'}, RUT{Number: 1009, DV: 'K'}, -1},
+	}
+
+	for _, tt := range tests {
+		if got := Compare(tt.a, tt.b); got != tt.expected {
+			t.Errorf("Compare(%v, %v) = %d; want %d", tt.a, tt.b, got, tt.expected)
+		}
+		if got := tt.a.Compare(tt.b); got != tt.expected {
+			t.Errorf("%v.Compare(%v) = %d; want %d", tt.a, tt.b, got, tt.expected)
+		}
+		if got := tt.a.Less(tt.b); got != (tt.expected < 0) {
+			t.Errorf("%v.Less(%v) = %v; want %v", tt.a, tt.b, got, tt.expected < 0)
+		}
+		if got := tt.a.Equal(tt.b); got != (tt.expected == 0) {
+			t.Errorf("%v.Equal(%v) = %v; want %v", tt.a, tt.b, got, tt.expected == 0)
+		}
+	}
+}
+
+func TestRUTSlice(t *testing.T) {
+	input := []RUT{
+		MustParse("12.345.678-5"),
+		MustParse("1.009-K"),
+		MustParse("7.654.321-6"),
+		MustParse("11.111.111-1"),
+	}
+	want := []RUT{
+		MustParse("1.009-K"),
+		MustParse("7.654.321-6"),
+		MustParse("11.111.111-1"),
+		MustParse("12.345.678-5"),
+	}
+
+	byInterface := slices.Clone(input)
+	sort.Sort(RUTSlice(byInterface))
+	if !slices.Equal(byInterface, want) {
+		t.Errorf("sort.Sort() = %v; want %v", byInterface, want)
+	}
+
+	bySort := slices.Clone(input)
+	RUTSlice(bySort).Sort()
+	if !slices.Equal(bySort, want) {
+		t.Errorf("RUTSlice.Sort() = %v; want %v", bySort, want)
+	}
+
+	if i, ok := RUTSlice(want).Search(MustParse("11.111.111-1")); i != 2 || !ok {
+		t.Errorf("Search(11.111.111-1) = %d, %v; want 2, true", i, ok)
+	}
+	if i, ok := RUTSlice(want).Search(MustParse("9.999.999-3")); i != 2 || ok {
+		t.Errorf("Search(9.999.999-3) = %d, %v; want 2, false", i, ok)
+	}
+}
+
+func TestRUT_NextPrev(t *testing.T) {
+	withDV := func(n int) RUT { return RUT{Number: n, DV: CalculateDV(n)} }
+	var afterNine RUT // Above the largest number on 32-bit platforms
+	if maxNumber > 999_999_999 {
+		afterNine = withDV(1_000_000_000)
+	}
+
+	tests := []struct {
+		r, next, prev RUT
+	}{
+		{MustParse("1.009-K"), MustParse("1.010-3"), MustParse("1.008-1")},
+		{RUT{Number: 1, DV: '9'}, RUT{Number: 2, DV: '7'}, RUT{}},
+		{MustParse("999.999.999-6"), afterNine, MustParse("999.999.998-8")},
+		{withDV(maxNumber), RUT{}, withDV(maxNumber - 1)},
+		{RUT{}, RUT{Number: 1, DV: '9'}, RUT{}},
+	}
+
+	for _, tt := range tests {
+		if got := tt.r.Next(); got != tt.next {
+			t.Errorf("%v.Next() = %v; want %v", tt.r, got, tt.next)
+		}
+		if got := tt.r.Prev(); got != tt.prev {
+			t.Errorf("%v.Prev() = %v; want %v", tt.r, got, tt.prev)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package rut
+
+import (
+	"reflect"
+	"strconv"
+)
+
+// Decode implements the Decoder interface of
+// github.com/kelseyhightower/envconfig, so RUT settings read from
+// environment variables are validated at startup like UnmarshalText.
+func (r *RUT) Decode(value string) error {
+	return r.UnmarshalText([]byte(value))
+}
+
+// UnmarshalParam implements the BindUnmarshaler interface of the Echo web
+// framework for query, path and form parameters.
+func (r *RUT) UnmarshalParam(param string) error {
+	return r.UnmarshalText([]byte(param))
+}
+
+var rutType = reflect.TypeOf(RUT{})
+
+// DecodeHook is a mapstructure decode hook, as used by viper and koanf,
+// converting strings and integers to RUT fields:
+//
+//	viper.Unmarshal(&cfg, viper.DecodeHook(rut.DecodeHook))
+//
+// Integers come from unquoted values without separators in YAML or TOML
+// files. Other conversions are left to mapstructure.
+func DecodeHook(from, to reflect.Type, data any) (any, error) {
+	if to != rutType {
+		return data, nil
+	}
+
+	var s string
+	switch v := reflect.ValueOf(data); from.Kind() {
+	case reflect.String:
+		s = v.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		s = strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		s = strconv.FormatUint(v.Uint(), 10)
+	default:
+		return data, nil
+	}
+
+	var r RUT
+	if err := r.UnmarshalText([]byte(s)); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
@@ -0,0 +1,47 @@
+package rut
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestDecodeHook(t *testing.T) {
+	tests := []struct {
+		data    any
+		want    any
+		wantErr error
+	}{
+		{"60.803.000-K", MustParse("60.803.000-K"), nil},
+		{123456785, MustParse("12.345.678-5"), nil},
+		{uint32(10099), RUT{}, ErrInvalidDV},
+		{"12.345.678-0", RUT{}, ErrInvalidDV},
+		{1.5, 1.5, nil},
+	}
+
+	for _, tt := range tests {
+		got, err := DecodeHook(reflect.TypeOf(tt.data), rutType, tt.data)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("DecodeHook(%v) error = %v; want %v", tt.data, err, tt.wantErr)
+			continue
+		}
+		if err == nil && got != tt.want {
+			t.Errorf("DecodeHook(%v) = %v; want %v", tt.data, got, tt.want)
+		}
+	}
+
+	// Other target types pass through
+	if got, err := DecodeHook(reflect.TypeOf(""), reflect.TypeOf(""), "x"); got != "x" || err != nil {
+		t.Errorf("DecodeHook(string to string) = %v, %v; want x, nil", got, err)
+	}
+}
+
+func TestRUT_Decode(t *testing.T) {
+	var r RUT
+	if err := r.Decode("60803000k"); err != nil || r != MustParse("60.803.000-K") {
+		t.Errorf("Decode(60803000k) = %v, %v; want 60.803.000-K, nil", r, err)
+	}
+	if err := r.UnmarshalParam("60803000-1"); !errors.Is(err, ErrInvalidDV) {
+		t.Errorf("UnmarshalParam(60803000-1) error = %v; want %v", err, ErrInvalidDV)
+	}
+}
@@ -0,0 +1,97 @@
+package rut
+
+import (
+	"bufio"
+	"io"
+	"unicode/utf8"
+)
+
+// cp1252 maps the bytes 0x80 to 0x9F of Windows-1252 to Unicode. Bytes from
+// 0xA0 up match ISO-8859-1 and map to the code point of the same value.
+// Undefined bytes map to the C1 control of the same value.
+var cp1252 = [32]rune{
+	'€', '\u0081', '‚', 'ƒ', '„', '…', '†', '‡',
+	'ˆ', '‰', 'Š', '‹', 'Œ', '\u008d', 'Ž', '\u008f',
+	'\u0090', '‘', '’', '“', '”', '•', '–', '—',
+	'˜', '™', 'š', '›', 'œ', '\u009d', 'ž', 'Ÿ',
+}
+
+// decodeChunk is the amount of output produced per underlying read.
+const decodeChunk = 4096
+
+// NewDecodingReader returns a reader that converts its input to UTF-8,
+// for legacy files exported by old bank and ERP systems.
+//
+// Valid UTF-8 sequences are passed through unchanged and any other byte is
+// decoded as Windows-1252, a superset of the printable ISO-8859-1 range.
+// This handles UTF-8, Latin-1 and files mixing both. A leading UTF-8 byte
+// order mark is removed.
+func NewDecodingReader(r io.Reader) io.Reader {
+	return &decodingReader{r: bufio.NewReader(r), bom: true}
+}
+
+// NewLatin1Reader returns a reader that decodes its input as Windows-1252
+// (and therefore ISO-8859-1) into UTF-8, without detection.
+func NewLatin1Reader(r io.Reader) io.Reader {
+	return &decodingReader{r: bufio.NewReader(r), latin1: true}
+}
+
+type decodingReader struct {
+	r      *bufio.Reader
+	latin1 bool   // Decode every byte as Windows-1252
+	bom    bool   // Skip a leading byte order mark
+	buf    []byte // Decoded output not yet returned
+	err    error  // Deferred read error
+}
+
+func (d *decodingReader) Read(p []byte) (int, error) {
+	for len(d.buf) == 0 {
+		if d.err != nil {
+			return 0, d.err
+		}
+		d.fill()
+	}
+	n := copy(p, d.buf)
+	d.buf = d.buf[n:]
+	return n, nil
+}
+
+// fill decodes up to decodeChunk bytes of output into buf.
+func (d *decodingReader) fill() {
+	if d.bom {
+		d.bom = false
+		if b, _ := d.r.Peek(3); string(b) == "\xef\xbb\xbf" {
+			d.r.Discard(3)
+		}
+	}
+
+	d.buf = d.buf[:0]
+	for len(d.buf) < decodeChunk {
+		c, err := d.r.ReadByte()
+		if err != nil {
+			d.err = err
+			return
+		}
+		if c < utf8.RuneSelf {
+			d.buf = append(d.buf, c)
+			continue
+		}
+
+		if !d.latin1 {
+			d.r.UnreadByte()
+			b, _ := d.r.Peek(utf8.UTFMax)
+			if r, size := utf8.DecodeRune(b); r != utf8.RuneError || size > 1 {
+				d.buf = append(d.buf, b[:size]...)
+				d.r.Discard(size)
+				continue
+			}
+			d.r.ReadByte()
+		}
+
+		if c < 0xa0 {
+			d.buf = utf8.AppendRune(d.buf, cp1252[c-0x80])
+		} else {
+			d.buf = utf8.AppendRune(d.buf, rune(c))
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package rut
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestNewDecodingReader(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"ascii", "12.345.678-5\n", "12.345.678-5\n"},
+		{"utf8", "José Pérez;12.345.678-5\n", "José Pérez;12.345.678-5\n"},
+		{"latin1", "Jos\xe9 P\xe9rez;12.345.678-5\n", "José Pérez;12.345.678-5\n"},
+		{"windows1252", "N\xba 12.345.678\x965 \x80", "Nº 12.345.678–5 €"},
+		{"mixed", "Jos\xe9;José\n", "José;José\n"},
+		{"bom", "\xef\xbb\xbfRUT;Nombre\n", "RUT;Nombre\n"},
+		{"truncated utf8", "Jos\xc3", "JosÃ"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := io.ReadAll(NewDecodingReader(strings.NewReader(tt.input)))
+			if err != nil {
+				t.Fatalf("ReadAll() error = %v", err)
+			}
+			if string(got) != tt.expected {
+				t.Errorf("ReadAll() = %q; want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNewLatin1Reader(t *testing.T) {
+	got, err := io.ReadAll(NewLatin1Reader(strings.NewReader("Jos\xc3\xa9")))
+	if err != nil {
+		t.Fatalf("ReadAll() error = %v", err)
+	}
+	if string(got) != "JosÃ©" {
+		t.Errorf("ReadAll() = %q; want %q", got, "JosÃ©")
+	}
+}
+
+func TestNewDecodingReader_Large(t *testing.T) {
+	input := strings.Repeat("Mu\xf1oz;12.345.678-5\n", 1000)
+	expected := strings.Repeat("Muñoz;12.345.678-5\n", 1000)
+
+	r := iotest.OneByteReader(strings.NewReader(input))
+	if err := iotest.TestReader(NewDecodingReader(r), []byte(expected)); err != nil {
+		t.Error(err)
+	}
+}
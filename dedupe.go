@@ -0,0 +1,50 @@
+package rut
+
+import "slices"
+
+// DuplicateGroup is a RUT and the inputs that normalize to it, see Dedupe.
+type DuplicateGroup struct {
+	RUT      RUT
+	Variants []string // Distinct inputs, in order of first appearance
+	Indexes  []int    // Position in the input of every occurrence
+}
+
+// Duplicated reports whether the RUT appears more than once.
+func (g DuplicateGroup) Duplicated() bool {
+	return len(g.Indexes) > 1
+}
+
+// Dedupe groups values that parse to the same RUT, regardless of dots,
+// dashes, spaces, leading zeros or the case of the 'K', and reports which
+// raw variants were merged, for merging duplicate customer records:
+//
+//	groups, invalid := rut.Dedupe([]string{"12.345.678-5", "12345678-5", "1.009-K", "1009-k"})
+//	// groups[0]: 12.345.678-5, Variants ["12.345.678-5" "12345678-5"], Indexes [0 1]
+//	// groups[1]: 1.009-K, Variants ["1.009-K" "1009-k"], Indexes [2 3]
+//
+// Groups are returned in order of first appearance, one per RUT, including
+// RUTs that appear once. The check digit is not verified, so check
+// DuplicateGroup.RUT.Validate before merging. invalid holds the positions
+// of values that do not parse.
+func Dedupe(values []string) (groups []DuplicateGroup, invalid []int) {
+	index := make(map[RUT]int)
+	for i, v := range values {
+		r, err := Parse(v)
+		if err != nil {
+			invalid = append(invalid, i)
+			continue
+		}
+
+		g, ok := index[r]
+		if !ok {
+			g = len(groups)
+			index[r] = g
+			groups = append(groups, DuplicateGroup{RUT: r})
+		}
+		groups[g].Indexes = append(groups[g].Indexes, i)
+		if !slices.Contains(groups[g].Variants, v) {
+			groups[g].Variants = append(groups[g].Variants, v)
+		}
+	}
+	return groups, invalid
+}
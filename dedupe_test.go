@@ -0,0 +1,37 @@
+package rut
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDedupe(t *testing.T) {
+	values := []string{"12.345.678-5", "12345678-5", "1.009-K", "abc", "1009-k", "12.345.678-5", " 012345678-5", ""}
+	groups, invalid := Dedupe(values)
+
+	want := []DuplicateGroup{
+		{
+			RUT:      RUT{Number: 12_345_678, DV: '5'},
+			Variants: []string{"12.345.678-5", "12345678-5", " 012345678-5"},
+			Indexes:  []int{0, 1, 5, 6},
+		},
+		{
+			RUT:      RUT{Number: 1009, DV: 'K'},
+			Variants: []string{"1.009-K", "1009-k"},
+			Indexes:  []int{2, 4},
+		},
+	}
+	if !reflect.DeepEqual(groups, want) {
+		t.Errorf("Dedupe() groups = %+v; want %+v", groups, want)
+	}
+	if wantInvalid := []int{3, 7}; !reflect.DeepEqual(invalid, wantInvalid) {
+		t.Errorf("Dedupe() invalid = %v; want %v", invalid, wantInvalid)
+	}
+}
+
+func TestDuplicateGroup_Duplicated(t *testing.T) {
+	groups, _ := Dedupe([]string{"1.009-K", "12.345.678-5", "1009-K"})
+	if !groups[0].Duplicated() || groups[1].Duplicated() {
+		t.Errorf("Duplicated() = %v, %v; want true, false", groups[0].Duplicated(), groups[1].Duplicated())
+	}
+}
@@ -0,0 +1,119 @@
+package rut
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// ErrNotSorted is returned by DeltaEncoder.Encode when RUTs are not given
+// in increasing order.
+var ErrNotSorted = errors.New("rut: RUTs not in increasing order")
+
+// A delta encoded stream starts with the magic "RUTD" and a version byte,
+// followed by one unsigned varint per RUT holding the difference with the
+// previous number (the first one holds the number itself).
+const (
+	deltaMagic   = "RUTD"
+	deltaVersion = 1
+)
+
+// DeltaEncoder writes a sorted list of RUTs as varint deltas. Dense sorted
+// lists take one or two bytes per RUT instead of the ten or more of their
+// text form. Only numbers are stored, check digits are recomputed when
+// decoding.
+type DeltaEncoder struct {
+	w    *bufio.Writer
+	prev int
+	err  error
+}
+
+// NewDeltaEncoder returns an encoder writing to w. Call Close to flush
+// buffered data.
+func NewDeltaEncoder(w io.Writer) *DeltaEncoder {
+	e := &DeltaEncoder{w: bufio.NewWriter(w)}
+	e.w.WriteString(deltaMagic)
+	e.w.WriteByte(deltaVersion)
+	return e
+}
+
+// Encode writes r. RUTs must be given in non-decreasing order, otherwise
+// ErrNotSorted is returned, and must be valid, otherwise ErrInvalidDV is
+// returned since the check digit is not stored. Numbers above MaxSetNumber
+// are rejected with ErrOutOfRange, as DeltaDecoder does not read them.
+func (e *DeltaEncoder) Encode(r RUT) error {
+	if e.err != nil {
+		return e.err
+	}
+	if r.Number > MaxSetNumber {
+		return ErrOutOfRange
+	}
+	if !r.Validate() {
+		return ErrInvalidDV
+	}
+	if r.Number < e.prev {
+		return ErrNotSorted
+	}
+
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], uint64(r.Number-e.prev))
+	if _, err := e.w.Write(buf[:n]); err != nil {
+		e.err = err
+		return err
+	}
+	e.prev = r.Number
+	return nil
+}
+
+// Close flushes buffered data to the underlying writer, which is not
+// closed.
+func (e *DeltaEncoder) Close() error {
+	if e.err != nil {
+		return e.err
+	}
+	return e.w.Flush()
+}
+
+// DeltaDecoder reads a list written by DeltaEncoder one RUT at a time, so
+// consumers never hold the whole list in memory.
+type DeltaDecoder struct {
+	r      *bufio.Reader
+	prev   int
+	header bool
+}
+
+// NewDeltaDecoder returns a decoder reading from r.
+func NewDeltaDecoder(r io.Reader) *DeltaDecoder {
+	return &DeltaDecoder{r: bufio.NewReader(r)}
+}
+
+// Next returns the next RUT in the list, or io.EOF at its end. It returns
+// ErrInvalidEncoding if the stream is corrupt or truncated.
+func (d *DeltaDecoder) Next() (RUT, error) {
+	if !d.header {
+		var header [len(deltaMagic) + 1]byte
+		if _, err := io.ReadFull(d.r, header[:]); err != nil {
+			return RUT{}, headerError(err)
+		}
+		if string(header[:4]) != deltaMagic || header[4] != deltaVersion {
+			return RUT{}, ErrInvalidEncoding
+		}
+		d.header = true
+	}
+
+	delta, err := binary.ReadUvarint(d.r)
+	if err == io.EOF {
+		return RUT{}, io.EOF
+	}
+	if err != nil {
+		return RUT{}, headerError(err)
+	}
+	number := uint64(d.prev) + delta
+	if number > MaxSetNumber {
+		return RUT{}, ErrInvalidEncoding
+	}
+
+	d.prev = int(number)
+	return RUT{Number: d.prev, DV: CalculateDV(d.prev)}, nil
+}
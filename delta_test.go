@@ -0,0 +1,88 @@
+package rut
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"slices"
+	"testing"
+)
+
+func TestDeltaEncoder(t *testing.T) {
+	var want []RUT
+	for n := 1_000_000; n < 1_200_000; n += 3 {
+		want = append(want, RUT{Number: n, DV: CalculateDV(n)})
+	}
+	want = append(want, want[len(want)-1], MustParse("99.999.999-9"))
+
+	var buf bytes.Buffer
+	e := NewDeltaEncoder(&buf)
+	for _, r := range want {
+		if err := e.Encode(r); err != nil {
+			t.Fatalf("Encode(%v) error = %v", r, err)
+		}
+	}
+	if err := e.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	// Deltas of 3 take a single byte each
+	if buf.Len() > len(want)+16 {
+		t.Errorf("encoded size = %d bytes; want about one byte per RUT (%d)", buf.Len(), len(want))
+	}
+
+	var got []RUT
+	d := NewDeltaDecoder(&buf)
+	for {
+		r, err := d.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Next() error = %v", err)
+		}
+		got = append(got, r)
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("decoded %d RUTs that differ from the %d encoded", len(got), len(want))
+	}
+}
+
+func TestDeltaEncoder_Errors(t *testing.T) {
+	e := NewDeltaEncoder(io.Discard)
+	if err := e.Encode(MustParse("12.345.678-5")); err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	if err := e.Encode(MustParse("1.009-K")); !errors.Is(err, ErrNotSorted) {
+		t.Errorf("Encode(smaller) error = %v; want %v", err, ErrNotSorted)
+	}
+	if err := e.Encode(MustParse("12.345.679-0")); !errors.Is(err, ErrInvalidDV) {
+		t.Errorf("Encode(invalid) error = %v; want %v", err, ErrInvalidDV)
+	}
+	if maxNumber > MaxSetNumber {
+		big := RUT{Number: MaxSetNumber + 1, DV: CalculateDV(MaxSetNumber + 1)}
+		if err := e.Encode(big); !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("Encode(%v) error = %v; want %v", big, err, ErrOutOfRange)
+		}
+	}
+}
+
+func TestDeltaDecoder_Invalid(t *testing.T) {
+	tests := map[string][]byte{
+		"empty":     nil,
+		"magic":     []byte("XXXX\x01\x05"),
+		"version":   []byte("RUTD\x02\x05"),
+		"truncated": []byte("RUTD\x01\xff"),
+		"overflow":  []byte("RUTD\x01\xff\xff\xff\xff\x0f"),
+	}
+	for name, input := range tests {
+		_, err := NewDeltaDecoder(bytes.NewReader(input)).Next()
+		if !errors.Is(err, ErrInvalidEncoding) {
+			t.Errorf("%s: Next() error = %v; want %v", name, err, ErrInvalidEncoding)
+		}
+	}
+
+	if _, err := NewDeltaDecoder(bytes.NewReader([]byte("RUTD\x01"))).Next(); err != io.EOF {
+		t.Errorf("empty list: Next() error = %v; want %v", err, io.EOF)
+	}
+}
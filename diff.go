@@ -0,0 +1,165 @@
+package rut
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+)
+
+// RUTReader is a stream of RUTs read one at a time, such as a DeltaDecoder
+// or a LineDecoder. Next returns io.EOF at the end of the stream.
+type RUTReader interface {
+	Next() (RUT, error)
+}
+
+var (
+	_ RUTReader = (*DeltaDecoder)(nil)
+	_ RUTReader = (*LineDecoder)(nil)
+)
+
+// LineDecoder reads RUTs from text, one per line in any format accepted by
+// Parse. Blank lines are skipped.
+type LineDecoder struct {
+	sc   *bufio.Scanner
+	line int
+}
+
+// NewLineDecoder returns a decoder reading lines from r.
+func NewLineDecoder(r io.Reader) *LineDecoder {
+	return &LineDecoder{sc: bufio.NewScanner(r)}
+}
+
+// Next returns the RUT on the next non-blank line, or io.EOF at the end of
+// the input. Lines that do not parse are reported with their line number.
+func (d *LineDecoder) Next() (RUT, error) {
+	for d.sc.Scan() {
+		d.line++
+		line := bytes.TrimSpace(d.sc.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		r, err := ParseBytes(line)
+		if err != nil {
+			return RUT{}, fmt.Errorf("rut: line %d: %w", d.line, err)
+		}
+		return r, nil
+	}
+	if err := d.sc.Err(); err != nil {
+		return RUT{}, err
+	}
+	return RUT{}, io.EOF
+}
+
+// DiffFuncs receives the result of Diff and DiffSets. Nil functions are
+// not called.
+type DiffFuncs struct {
+	Added     func(RUT) // Only in after
+	Removed   func(RUT) // Only in before
+	Unchanged func(RUT) // In both
+}
+
+// DiffStats counts the RUTs reported by Diff and DiffSets.
+type DiffStats struct {
+	Added, Removed, Unchanged int
+}
+
+func (d DiffFuncs) emit(fn func(RUT), n *int, r RUT) {
+	*n++
+	if fn != nil {
+		fn(r)
+	}
+}
+
+// Diff compares two lists of RUTs sorted in increasing order, such as the
+// padrón or an allowlist of two consecutive days, and streams every RUT
+// to the matching function of fns in increasing order. RUTs are compared
+// by number and repeated RUTs are reported once. Neither list is held in
+// memory. Diff returns ErrNotSorted if a list is out of order, and stops at
+// the first error of either reader; unsorted text files can be prepared
+// with SortDedup.
+//
+//	stats, err := rut.Diff(rut.NewLineDecoder(yesterday), rut.NewLineDecoder(today), rut.DiffFuncs{
+//		Added:   func(r rut.RUT) { fmt.Fprintln(added, r) },
+//		Removed: func(r rut.RUT) { fmt.Fprintln(removed, r) },
+//	})
+func Diff(before, after RUTReader, fns DiffFuncs) (DiffStats, error) {
+	var stats DiffStats
+	a, b := sortedReader{r: before}, sortedReader{r: after}
+	ra, okA, err := a.next()
+	if err != nil {
+		return stats, err
+	}
+	rb, okB, err := b.next()
+	if err != nil {
+		return stats, err
+	}
+
+	for okA || okB {
+		switch {
+		case okA && (!okB || ra.Number < rb.Number):
+			fns.emit(fns.Removed, &stats.Removed, ra)
+			ra, okA, err = a.next()
+		case okB && (!okA || rb.Number < ra.Number):
+			fns.emit(fns.Added, &stats.Added, rb)
+			rb, okB, err = b.next()
+		default:
+			fns.emit(fns.Unchanged, &stats.Unchanged, rb)
+			if ra, okA, err = a.next(); err == nil {
+				rb, okB, err = b.next()
+			}
+		}
+		if err != nil {
+			return stats, err
+		}
+	}
+	return stats, nil
+}
+
+// sortedReader returns the distinct RUTs of r, checking their order.
+type sortedReader struct {
+	r       RUTReader
+	prev    int
+	started bool
+}
+
+func (s *sortedReader) next() (RUT, bool, error) {
+	for {
+		r, err := s.r.Next()
+		if err == io.EOF {
+			return RUT{}, false, nil
+		}
+		if err != nil {
+			return RUT{}, false, err
+		}
+		if s.started && r.Number < s.prev {
+			return RUT{}, false, ErrNotSorted
+		}
+		if s.started && r.Number == s.prev {
+			continue
+		}
+		s.prev, s.started = r.Number, true
+		return r, true, nil
+	}
+}
+
+// DiffSets compares two sets like Diff compares two lists. Removed and
+// unchanged RUTs are reported first, in increasing order, then added ones.
+func DiffSets(before, after Set, fns DiffFuncs) DiffStats {
+	var stats DiffStats
+	before.Each(func(r RUT) bool {
+		if after.Contains(r) {
+			fns.emit(fns.Unchanged, &stats.Unchanged, r)
+		} else {
+			fns.emit(fns.Removed, &stats.Removed, r)
+		}
+		return true
+	})
+	after.Each(func(r RUT) bool {
+		if !before.Contains(r) {
+			fns.emit(fns.Added, &stats.Added, r)
+		}
+		return true
+	})
+	return stats
+}
@@ -0,0 +1,124 @@
+package rut
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// diffResult collects the output of Diff as formatted RUTs.
+type diffResult struct {
+	added, removed, unchanged []string
+}
+
+func (d *diffResult) funcs() DiffFuncs {
+	return DiffFuncs{
+		Added:     func(r RUT) { d.added = append(d.added, r.String()) },
+		Removed:   func(r RUT) { d.removed = append(d.removed, r.String()) },
+		Unchanged: func(r RUT) { d.unchanged = append(d.unchanged, r.String()) },
+	}
+}
+
+func TestLineDecoder(t *testing.T) {
+	d := NewLineDecoder(strings.NewReader("1.009-K\n\n  12345678-5 \nabc\n"))
+	var got []RUT
+	var err error
+	for {
+		var r RUT
+		if r, err = d.Next(); err != nil {
+			break
+		}
+		got = append(got, r)
+	}
+	want := []RUT{{Number: 1009, DV: 'K'}, {Number: 12_345_678, DV: '5'}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Next() = %v; want %v", got, want)
+	}
+	if !errors.Is(err, ErrInvalidFormat) || !strings.Contains(err.Error(), "line 4") {
+		t.Errorf("Next() error = %v; want ErrInvalidFormat on line 4", err)
+	}
+	if _, err := d.Next(); err != io.EOF {
+		t.Errorf("Next() at end = %v; want io.EOF", err)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	before := "1.000-6\n1.009-K\n1009-K\n5.000.000-1\n12.345.678-5\n"
+	after := "1.009-K\n2.000.000-7\n12.345.678-5\n20.000.000-5\n"
+
+	var res diffResult
+	stats, err := Diff(NewLineDecoder(strings.NewReader(before)), NewLineDecoder(strings.NewReader(after)), res.funcs())
+	if err != nil {
+		t.Fatalf("Diff() error = %v", err)
+	}
+	want := diffResult{
+		added:     []string{"2.000.000-7", "20.000.000-5"},
+		removed:   []string{"1.000-6", "5.000.000-1"},
+		unchanged: []string{"1.009-K", "12.345.678-5"},
+	}
+	if !reflect.DeepEqual(res, want) {
+		t.Errorf("Diff() = %+v; want %+v", res, want)
+	}
+	if want := (DiffStats{Added: 2, Removed: 2, Unchanged: 2}); stats != want {
+		t.Errorf("Diff() stats = %+v; want %+v", stats, want)
+	}
+}
+
+func TestDiff_DeltaDecoder(t *testing.T) {
+	var buf bytes.Buffer
+	e := NewDeltaEncoder(&buf)
+	for _, n := range []int{1000, 1009, 12_345_678} {
+		e.Encode(RUT{Number: n, DV: CalculateDV(n)})
+	}
+	e.Close()
+
+	stats, err := Diff(NewDeltaDecoder(&buf), NewLineDecoder(strings.NewReader("1.009-K\n")), DiffFuncs{})
+	if want := (DiffStats{Removed: 2, Unchanged: 1}); err != nil || stats != want {
+		t.Errorf("Diff() = %+v, %v; want %+v, nil", stats, err, want)
+	}
+}
+
+func TestDiff_Errors(t *testing.T) {
+	tests := []struct {
+		before, after string
+		want          error
+	}{
+		{"12.345.678-5\n1.009-K\n", "", ErrNotSorted},
+		{"", "1.009-K\n1.000-6\n", ErrNotSorted},
+		{"1.000-6\n", "1.000-6\nabc\n", ErrInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		_, err := Diff(NewLineDecoder(strings.NewReader(tt.before)), NewLineDecoder(strings.NewReader(tt.after)), DiffFuncs{})
+		if !errors.Is(err, tt.want) {
+			t.Errorf("Diff(%q, %q) error = %v; want %v", tt.before, tt.after, err, tt.want)
+		}
+	}
+}
+
+func TestDiffSets(t *testing.T) {
+	before, after := NewSparseSet(), &RUTSet{}
+	for _, s := range []string{"1.000-6", "1.009-K", "12.345.678-5"} {
+		before.Add(MustParse(s))
+	}
+	for _, s := range []string{"1.009-K", "2.000.000-7", "12.345.678-5"} {
+		after.Add(MustParse(s))
+	}
+
+	var res diffResult
+	stats := DiffSets(before, after, res.funcs())
+	want := diffResult{
+		added:     []string{"2.000.000-7"},
+		removed:   []string{"1.000-6"},
+		unchanged: []string{"1.009-K", "12.345.678-5"},
+	}
+	if !reflect.DeepEqual(res, want) {
+		t.Errorf("DiffSets() = %+v; want %+v", res, want)
+	}
+	if want := (DiffStats{Added: 1, Removed: 1, Unchanged: 2}); stats != want {
+		t.Errorf("DiffSets() stats = %+v; want %+v", stats, want)
+	}
+}
@@ -0,0 +1,103 @@
+package rut
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// DTEFields are the elements of SII electronic tax documents (DTE) and
+// their envelopes that hold a RUT, checked by ValidateDTE.
+var DTEFields = []string{
+	"RUTEmisor", "RUTRecep", "RUTSolicita", "RUTMandante", "RUTProvSW",
+	"RUTTrans", "RUTChofer",
+	"RutEmisor", "RutEnvia", "RutReceptor", // Carátula of EnvioDTE and EnvioBOLETA
+	"RE", "RR", // Timbre electrónico (TED) and CAF
+}
+
+// dteParser accepts RUTs as the SII schemas define them, "12345678-5".
+var dteParser = NewParser(WithStyles(FormatWithDash), WithVerifyDV())
+
+// ValidateDTE checks every RUT field of a DTE XML document, such as a DTE,
+// an EnvioDTE or an EnvioBOLETA, before submitting it to the SII. Fields are
+// the elements named in DTEFields, in any namespace. Their value must be
+// written as "12345678-5", with an uppercase 'K' and a valid check digit.
+//
+// Failures are returned joined with errors.Join, each one as a *FieldError
+// whose Field is the XPath of the element, as in
+// "/EnvioDTE/SetDTE/DTE[2]/Documento/Encabezado/Receptor/RUTRecep". Repeated
+// elements after the first carry their position. Documents may be encoded
+// in UTF-8 or, as the SII requires, ISO-8859-1. Malformed XML stops the
+// check and returns the decoding error.
+func ValidateDTE(r io.Reader) error {
+	fields := make(map[string]bool, len(DTEFields))
+	for _, name := range DTEFields {
+		fields[name] = true
+	}
+
+	type level struct {
+		path     string
+		children map[string]int
+	}
+	stack := []level{{children: make(map[string]int)}}
+	var text strings.Builder
+	var errs []error
+
+	d := xml.NewDecoder(r)
+	d.CharsetReader = dteCharsetReader
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			parent := &stack[len(stack)-1]
+			name := t.Name.Local
+			parent.children[name]++
+			path := parent.path + "/" + name
+			if n := parent.children[name]; n > 1 {
+				path += "[" + strconv.Itoa(n) + "]"
+			}
+			stack = append(stack, level{path: path, children: make(map[string]int)})
+			text.Reset()
+		case xml.CharData:
+			text.Write(t)
+		case xml.EndElement:
+			if fields[t.Name.Local] {
+				if err := validateDTEField(strings.TrimSpace(text.String())); err != nil {
+					errs = append(errs, &FieldError{Field: stack[len(stack)-1].path, Err: err})
+				}
+			}
+			stack = stack[:len(stack)-1]
+			text.Reset()
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func validateDTEField(s string) error {
+	if _, err := dteParser.Parse(s); err != nil {
+		return err
+	}
+	if i := strings.IndexByte(s, 'k'); i >= 0 {
+		return newParseError(s, i, CodeInvalidChar)
+	}
+	return nil
+}
+
+// dteCharsetReader decodes the ISO-8859-1 encoding of DTE documents.
+func dteCharsetReader(charset string, r io.Reader) (io.Reader, error) {
+	switch strings.ToLower(charset) {
+	case "iso-8859-1", "iso8859-1", "latin1", "windows-1252":
+		return NewLatin1Reader(r), nil
+	}
+	return nil, fmt.Errorf("rut: unsupported XML encoding %q", charset)
+}
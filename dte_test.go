@@ -0,0 +1,80 @@
+package rut
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+const testEnvioDTE = `<?xml version="1.0" encoding="ISO-8859-1"?>
+<EnvioDTE xmlns="http://www.sii.cl/SiiDte" version="1.0">
+  <SetDTE ID="SetDoc">
+    <Caratula version="1.0">
+      <RutEmisor>76086428-5</RutEmisor>
+      <RutEnvia>12345678-5</RutEnvia>
+      <RutReceptor>60803000-K</RutReceptor>
+    </Caratula>
+    <DTE version="1.0">
+      <Documento ID="F1">
+        <Encabezado>
+          <IdDoc><TipoDTE>33</TipoDTE></IdDoc>
+          <Emisor><RUTEmisor>76086428-5</RUTEmisor></Emisor>
+          <Receptor><RUTRecep>1009-K</RUTRecep><RznSocRecep>Compa` + "\xf1\xed" + `a</RznSocRecep></Receptor>
+        </Encabezado>
+        <TED><DD><RE>76086428-5</RE><RR>1009-K</RR></DD></TED>
+      </Documento>
+    </DTE>
+    <DTE version="1.0">
+      <Documento ID="F2">
+        <Encabezado>
+          <Emisor><RUTEmisor>76.086.428-5</RUTEmisor></Emisor>
+          <Receptor><RUTRecep>12345678-0</RUTRecep></Receptor>
+          <Transporte><RUTTrans> 1009-k </RUTTrans><Chofer><RUTChofer></RUTChofer></Chofer></Transporte>
+        </Encabezado>
+      </Documento>
+    </DTE>
+  </SetDTE>
+</EnvioDTE>`
+
+func TestValidateDTE(t *testing.T) {
+	err := ValidateDTE(strings.NewReader(testEnvioDTE))
+	want := []struct {
+		path string
+		err  error
+	}{
+		{"/EnvioDTE/SetDTE/DTE[2]/Documento/Encabezado/Emisor/RUTEmisor", ErrInvalidFormat},
+		{"/EnvioDTE/SetDTE/DTE[2]/Documento/Encabezado/Receptor/RUTRecep", ErrInvalidDV},
+		{"/EnvioDTE/SetDTE/DTE[2]/Documento/Encabezado/Transporte/RUTTrans", ErrInvalidFormat},
+		{"/EnvioDTE/SetDTE/DTE[2]/Documento/Encabezado/Transporte/Chofer/RUTChofer", ErrEmptyRUT},
+	}
+
+	errs := err.(interface{ Unwrap() []error }).Unwrap()
+	if len(errs) != len(want) {
+		t.Fatalf("ValidateDTE() = %v; want %d errors", err, len(want))
+	}
+	for i, e := range errs {
+		var ferr *FieldError
+		if !errors.As(e, &ferr) || ferr.Field != want[i].path || !errors.Is(e, want[i].err) {
+			t.Errorf("error %d = %v; want %s: %v", i, e, want[i].path, want[i].err)
+		}
+	}
+}
+
+func TestValidateDTE_Valid(t *testing.T) {
+	doc := `<DTE><Documento><Encabezado><Emisor><RUTEmisor>60803000-K</RUTEmisor></Emisor></Encabezado></Documento></DTE>`
+	if err := ValidateDTE(strings.NewReader(doc)); err != nil {
+		t.Errorf("ValidateDTE() = %v; want nil", err)
+	}
+}
+
+func TestValidateDTE_Malformed(t *testing.T) {
+	docs := []string{
+		`<DTE><RUTEmisor>60803000-K</DTE>`,
+		`<?xml version="1.0" encoding="Shift_JIS"?><DTE/>`,
+	}
+	for _, doc := range docs {
+		if err := ValidateDTE(strings.NewReader(doc)); err == nil {
+			t.Errorf("ValidateDTE(%q) = nil; want error", doc)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package rut
+
+import "errors"
+
+// ErrDTEResolution is returned by DTEProduction for a missing resolution
+// number: the SII rejects production envelopes whose Carátula has none.
+var ErrDTEResolution = errors.New("rut: missing SII resolution number")
+
+// DTEEnvironment holds the fixed values of an SII environment for
+// electronic tax documents (DTE), for integration and certification tests.
+//
+// The SII publishes no shared set of test taxpayers: in certification each
+// company sends documents with its own RUT as emisor, following test sets
+// generated for it, so tests should use their own RUT for the emisor and
+// these values for the rest.
+type DTEEnvironment struct {
+	Name        string
+	Host        string // Host of the web services and upload endpoint
+	RutReceptor RUT    // RutReceptor of the Carátula of every envelope, the SII
+	NroResol    int    // NroResol of the Carátula, 0 in certification
+}
+
+// siiRUT is the RUT of the SII, the receptor of every envelope.
+var siiRUT = RUT{Number: 60_803_000, DV: 'K'}
+
+// DTECertification is the SII certification environment.
+var DTECertification = DTEEnvironment{
+	Name:        "certificación",
+	Host:        "maullin.sii.cl",
+	RutReceptor: siiRUT,
+	NroResol:    0,
+}
+
+// DTEProduction returns the SII production environment for a company
+// authorized by the resolution numbered nroResol. Every company has its
+// own, so there is no default; it returns ErrDTEResolution if nroResol is
+// not positive.
+func DTEProduction(nroResol int) (DTEEnvironment, error) {
+	if nroResol <= 0 {
+		return DTEEnvironment{}, ErrDTEResolution
+	}
+	return DTEEnvironment{
+		Name:        "producción",
+		Host:        "palena.sii.cl",
+		RutReceptor: siiRUT,
+		NroResol:    nroResol,
+	}, nil
+}
@@ -0,0 +1,33 @@
+package rut
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDTEEnvironment(t *testing.T) {
+	prod, err := DTEProduction(80)
+	if err != nil || prod.NroResol != 80 {
+		t.Fatalf("DTEProduction(80) = %+v, %v; want NroResol 80", prod, err)
+	}
+	for _, env := range []DTEEnvironment{DTECertification, prod} {
+		if !env.RutReceptor.Validate() {
+			t.Errorf("%s: RutReceptor %v has an invalid check digit", env.Name, env.RutReceptor)
+		}
+		in, ok := LookupInstitution(env.RutReceptor)
+		if !ok || in.ShortName != "SII" {
+			t.Errorf("%s: RutReceptor %v = %v, %v; want the SII", env.Name, env.RutReceptor, in, ok)
+		}
+	}
+	if DTECertification.NroResol != 0 {
+		t.Errorf("DTECertification.NroResol = %d; want 0", DTECertification.NroResol)
+	}
+}
+
+func TestDTEProduction_NoResolution(t *testing.T) {
+	for _, nro := range []int{0, -1} {
+		if env, err := DTEProduction(nro); !errors.Is(err, ErrDTEResolution) {
+			t.Errorf("DTEProduction(%d) = %+v, %v; want %v", nro, env, err, ErrDTEResolution)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package rut
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// ErrDTEMismatch is reported by CheckEnvioDTE for a RUT that contradicts
+// another one in the same envelope.
+var ErrDTEMismatch = errors.New("rut: RUT mismatch in DTE envelope")
+
+// dteEnvelope holds the RUTs of an EnvioDTE or EnvioBOLETA.
+type dteEnvelope struct {
+	XMLName xml.Name
+	SetDTE  struct {
+		Caratula struct {
+			RutEmisor   string
+			RutEnvia    string
+			RutReceptor string
+		}
+		DTE []struct {
+			Documents []dteDocument `xml:",any"`
+		}
+	}
+}
+
+// dteDocument is a Documento, Exportaciones or Liquidacion element.
+type dteDocument struct {
+	XMLName    xml.Name
+	Encabezado struct {
+		Emisor   struct{ RUTEmisor string }
+		Receptor struct{ RUTRecep string }
+	}
+	TED struct {
+		DD struct {
+			RE  string
+			RR  string
+			CAF struct {
+				DA struct{ RE string }
+			}
+		}
+	}
+}
+
+// CheckEnvioDTE cross-checks the RUTs of an EnvioDTE or EnvioBOLETA, which
+// the SII rejects when they disagree even if each one is valid:
+//
+//   - RUTEmisor of every document, and RE of its timbre (TED) and CAF,
+//     must match RutEmisor of the Carátula;
+//   - RR of the timbre must match RUTRecep of the document;
+//   - RutReceptor of the Carátula must be the SII, 60.803.000-K, or the
+//     receptor of every document when the envelope is sent to the buyer;
+//   - RutEnvia, the holder of the signing certificate, must be a natural
+//     person.
+//
+// Mismatches are returned joined with errors.Join, each one as a
+// *FieldError wrapping ErrDTEMismatch with the XPath of the offending
+// element, as in ValidateDTE. Values that do not parse are left to
+// ValidateDTE. Malformed XML returns the decoding error.
+func CheckEnvioDTE(r io.Reader) error {
+	var env dteEnvelope
+	d := xml.NewDecoder(r)
+	d.CharsetReader = dteCharsetReader
+	if err := d.Decode(&env); err != nil {
+		return err
+	}
+
+	c := &env.SetDTE.Caratula
+	root := "/" + env.XMLName.Local + "/SetDTE"
+	var errs []error
+	mismatch := func(path, got string, want RUT, what string) {
+		g, err := Parse(got)
+		if err != nil || want.IsZero() || g.Equal(want) {
+			return
+		}
+		errs = append(errs, &FieldError{
+			Field: path,
+			Err:   fmt.Errorf("%w: %v, %s is %v", ErrDTEMismatch, g, what, want),
+		})
+	}
+
+	emisor, _ := Parse(c.RutEmisor)
+	receptor, _ := Parse(c.RutReceptor)
+	if envia, err := Parse(c.RutEnvia); err == nil && !envia.IsPerson() {
+		errs = append(errs, &FieldError{
+			Field: root + "/Caratula/RutEnvia",
+			Err:   fmt.Errorf("%w: %v is not a natural person", ErrDTEMismatch, envia),
+		})
+	}
+
+	toSII := receptor.Equal(siiRUT)
+	for i, dte := range env.SetDTE.DTE {
+		path := root + "/DTE"
+		if i > 0 {
+			path += "[" + strconv.Itoa(i+1) + "]"
+		}
+		for _, doc := range dte.Documents {
+			p := path + "/" + doc.XMLName.Local
+			mismatch(p+"/Encabezado/Emisor/RUTEmisor", doc.Encabezado.Emisor.RUTEmisor, emisor, "RutEmisor of the Carátula")
+			mismatch(p+"/TED/DD/RE", doc.TED.DD.RE, emisor, "RutEmisor of the Carátula")
+			mismatch(p+"/TED/DD/CAF/DA/RE", doc.TED.DD.CAF.DA.RE, emisor, "RutEmisor of the Carátula")
+			recep, _ := Parse(doc.Encabezado.Receptor.RUTRecep)
+			mismatch(p+"/TED/DD/RR", doc.TED.DD.RR, recep, "RUTRecep")
+			if !toSII {
+				mismatch(p+"/Encabezado/Receptor/RUTRecep", doc.Encabezado.Receptor.RUTRecep, receptor, "RutReceptor of the Carátula")
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,100 @@
+package rut
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+const testEnvelope = `<?xml version="1.0" encoding="ISO-8859-1"?>
+<EnvioDTE xmlns="http://www.sii.cl/SiiDte" version="1.0">
+  <SetDTE ID="SetDoc">
+    <Caratula version="1.0">
+      <RutEmisor>76086428-5</RutEmisor>
+      <RutEnvia>%s</RutEnvia>
+      <RutReceptor>%s</RutReceptor>
+    </Caratula>
+    <DTE version="1.0">
+      <Documento ID="F1">
+        <Encabezado>
+          <Emisor><RUTEmisor>76086428-5</RUTEmisor></Emisor>
+          <Receptor><RUTRecep>1009-K</RUTRecep></Receptor>
+        </Encabezado>
+        <TED><DD><RE>76086428-5</RE><RR>1009-K</RR><CAF><DA><RE>76086428-5</RE></DA></CAF></DD></TED>
+      </Documento>
+      <Signature xmlns="http://www.w3.org/2000/09/xmldsig#"/>
+    </DTE>
+    <DTE version="1.0">
+      <Exportaciones ID="F2">
+        <Encabezado>
+          <Emisor><RUTEmisor>%s</RUTEmisor></Emisor>
+          <Receptor><RUTRecep>1009-K</RUTRecep></Receptor>
+        </Encabezado>
+        <TED><DD><RE>76086428-5</RE><RR>%s</RR><CAF><DA><RE>%s</RE></DA></CAF></DD></TED>
+      </Exportaciones>
+    </DTE>
+  </SetDTE>
+</EnvioDTE>`
+
+func TestCheckEnvioDTE(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string // RutEnvia, RutReceptor, RUTEmisor, RR and CAF RE of the second document
+		paths  []string
+	}{
+		{"consistent", []string{"12345678-5", "60803000-K", "76086428-5", "1009-K", "76086428-5"}, nil},
+		{"sent to the buyer", []string{"12345678-5", "1009-K", "76086428-5", "1009-K", "76086428-5"}, nil},
+		{
+			"mismatches",
+			[]string{"12345678-5", "60803000-K", "76.086.428-5", "12345678-5", "60803000-K"},
+			[]string{
+				"/EnvioDTE/SetDTE/DTE[2]/Exportaciones/TED/DD/CAF/DA/RE",
+				"/EnvioDTE/SetDTE/DTE[2]/Exportaciones/TED/DD/RR",
+			},
+		},
+		{
+			"other emisor",
+			[]string{"12345678-5", "60803000-K", "60803000-K", "1009-K", "76086428-5"},
+			[]string{"/EnvioDTE/SetDTE/DTE[2]/Exportaciones/Encabezado/Emisor/RUTEmisor"},
+		},
+		{
+			"other receptor",
+			[]string{"60803000-K", "12345678-5", "76086428-5", "1009-K", "76086428-5"},
+			[]string{
+				"/EnvioDTE/SetDTE/Caratula/RutEnvia",
+				"/EnvioDTE/SetDTE/DTE/Documento/Encabezado/Receptor/RUTRecep",
+				"/EnvioDTE/SetDTE/DTE[2]/Exportaciones/Encabezado/Receptor/RUTRecep",
+			},
+		},
+		{"unparsable values", []string{"x", "60803000-K", "", "1009-K", "76086428-5"}, nil},
+	}
+
+	for _, tt := range tests {
+		doc := testEnvelope
+		for _, v := range tt.values {
+			doc = strings.Replace(doc, "%s", v, 1)
+		}
+		err := CheckEnvioDTE(strings.NewReader(doc))
+
+		var errs []error
+		if err != nil {
+			errs = err.(interface{ Unwrap() []error }).Unwrap()
+		}
+		if len(errs) != len(tt.paths) {
+			t.Errorf("%s: CheckEnvioDTE() = %v; want %d errors", tt.name, err, len(tt.paths))
+			continue
+		}
+		for i, e := range errs {
+			var ferr *FieldError
+			if !errors.As(e, &ferr) || ferr.Field != tt.paths[i] || !errors.Is(e, ErrDTEMismatch) {
+				t.Errorf("%s: error %d = %v; want mismatch at %s", tt.name, i, e, tt.paths[i])
+			}
+		}
+	}
+}
+
+func TestCheckEnvioDTE_Malformed(t *testing.T) {
+	if err := CheckEnvioDTE(strings.NewReader("<EnvioDTE><SetDTE>")); err == nil {
+		t.Error("CheckEnvioDTE() of malformed XML = nil; want error")
+	}
+}
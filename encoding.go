@@ -0,0 +1,146 @@
+package rut
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// AppendText implements encoding.TextAppender, appending the RUT in
+// FormatComplete style. The zero RUT appends nothing.
+func (r RUT) AppendText(b []byte) ([]byte, error) {
+	if r.IsZero() {
+		return b, nil
+	}
+	return r.AppendFormat(b, FormatComplete), nil
+}
+
+// MarshalText implements encoding.TextMarshaler using FormatComplete style.
+func (r RUT) MarshalText() ([]byte, error) {
+	return r.AppendText(make([]byte, 0, 13))
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts any format
+// supported by Parse and returns ErrInvalidDV if the check digit does not
+// match. Empty text decodes to the zero RUT, so unset values round trip.
+func (r *RUT) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*r = RUT{}
+		return nil
+	}
+	v, err := ParseBytes(text)
+	if err != nil {
+		return err
+	}
+	if !v.Validate() {
+		return dvError(text)
+	}
+	*r = v
+	return nil
+}
+
+// The binary form of a RUT is 5 bytes. The high nibble of the first byte
+// is the format version, currently 1, and the low nibble the check digit:
+// 0 to 9 for digits, 10 for K and 15 for none (the zero RUT). The number
+// follows as a big-endian uint32.
+//
+// The format is stable: version 1 data will always decode, and future
+// versions will use a different version nibble, which older decoders
+// reject with ErrInvalidEncoding.
+const (
+	binaryVersion = 1
+	binaryLen     = 5
+	binaryDVK     = 10
+	binaryNoDV    = 15
+)
+
+// AppendBinary implements encoding.BinaryAppender, appending the 5 byte
+// binary form of the RUT. It returns ErrInvalidFormat if the check digit is
+// not a digit or K.
+func (r RUT) AppendBinary(b []byte) ([]byte, error) {
+	var code byte
+	switch {
+	case r.DV >= '0' && r.DV <= '9':
+		code = r.DV - '0'
+	case r.DV == 'K' || r.DV == 'k':
+		code = binaryDVK
+	case r.DV == 0 && r.Number == 0:
+		code = binaryNoDV
+	default:
+		return b, ErrInvalidFormat
+	}
+	if r.Number < 0 || uint64(r.Number) > math.MaxUint32 {
+		return b, ErrInvalidFormat
+	}
+	b = append(b, binaryVersion<<4|code)
+	return binary.BigEndian.AppendUint32(b, uint32(r.Number)), nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, see AppendBinary.
+func (r RUT) MarshalBinary() ([]byte, error) {
+	return r.AppendBinary(make([]byte, 0, binaryLen))
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It returns
+// ErrInvalidEncoding for data not produced by MarshalBinary and
+// ErrInvalidDV if the check digit does not match.
+func (r *RUT) UnmarshalBinary(data []byte) error {
+	if len(data) != binaryLen || data[0]>>4 != binaryVersion {
+		return ErrInvalidEncoding
+	}
+	v := RUT{Number: int(binary.BigEndian.Uint32(data[1:]))}
+	switch code := data[0] & 0x0f; {
+	case code <= 9:
+		v.DV = '0' + code
+	case code == binaryDVK:
+		v.DV = 'K'
+	case code == binaryNoDV && v.Number == 0:
+		*r = v
+		return nil
+	default:
+		return ErrInvalidEncoding
+	}
+	if !v.Validate() {
+		return ErrInvalidDV
+	}
+	*r = v
+	return nil
+}
+
+// Pack returns the RUT number as a uint32, for columnar stores and
+// in-memory indexes that hold millions of RUTs. The check digit is not
+// stored and Unpack recomputes it, so only valid RUTs survive a round trip.
+// ok is false for numbers below 0 or above math.MaxUint32, which a Parser
+// accepts with WithLength but do not fit.
+func (r RUT) Pack() (v uint32, ok bool) {
+	if r.Number < 0 || uint64(r.Number) > math.MaxUint32 {
+		return 0, false
+	}
+	return uint32(r.Number), true
+}
+
+// Unpack returns the RUT packed by Pack, with its check digit.
+func Unpack(v uint32) RUT {
+	return RUT{Number: int(v), DV: CalculateDV(int(v))}
+}
+
+// MarshalYAML implements the yaml.Marshaler interface of gopkg.in/yaml.v3
+// and yaml.v2, encoding the RUT as a FormatComplete string, or an empty one
+// for the zero RUT.
+func (r RUT) MarshalYAML() (any, error) {
+	if r.IsZero() {
+		return "", nil
+	}
+	return r.Format(FormatComplete), nil
+}
+
+// UnmarshalYAML implements the function based yaml.Unmarshaler interface
+// supported by gopkg.in/yaml.v2, yaml.v3 and most other YAML libraries,
+// validating the RUT like UnmarshalText. Unquoted values without separators,
+// which YAML reads as integers, are accepted too.
+func (r *RUT) UnmarshalYAML(unmarshal func(any) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return r.UnmarshalText([]byte(s))
+}
@@ -0,0 +1,10 @@
+//go:build go1.24
+
+package rut
+
+import "encoding"
+
+var (
+	_ encoding.TextAppender   = RUT{}
+	_ encoding.BinaryAppender = RUT{}
+)
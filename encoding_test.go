@@ -0,0 +1,221 @@
+package rut
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestRUT_MarshalText(t *testing.T) {
+	r := RUT{Number: 12345678, DV: '5'}
+	got, err := r.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText() error = %v", err)
+	}
+	if string(got) != "12.345.678-5" {
+		t.Errorf("MarshalText() = %q; want %q", got, "12.345.678-5")
+	}
+
+	appended, err := r.AppendText([]byte("rut="))
+	if err != nil {
+		t.Fatalf("AppendText() error = %v", err)
+	}
+	if string(appended) != "rut=12.345.678-5" {
+		t.Errorf("AppendText() = %q; want %q", appended, "rut=12.345.678-5")
+	}
+
+	if got, err := (RUT{}).MarshalText(); len(got) != 0 || err != nil {
+		t.Errorf("RUT{}.MarshalText() = %q, %v; want empty", got, err)
+	}
+}
+
+func TestRUT_MarshalBinary(t *testing.T) {
+	tests := []struct {
+		r    RUT
+		want []byte
+	}{
+		{RUT{Number: 12345678, DV: '5'}, []byte{0x15, 0x00, 0xbc, 0x61, 0x4e}},
+		{RUT{Number: 1009, DV: 'K'}, []byte{0x1a, 0x00, 0x00, 0x03, 0xf1}},
+		{RUT{}, []byte{0x1f, 0x00, 0x00, 0x00, 0x00}},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.r.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary(%v) error = %v", tt.r, err)
+		}
+		if !bytes.Equal(got, tt.want) {
+			t.Errorf("MarshalBinary(%v) = %x; want %x", tt.r, got, tt.want)
+		}
+
+		var back RUT
+		if err := back.UnmarshalBinary(got); err != nil || back != tt.r {
+			t.Errorf("UnmarshalBinary(%x) = %v, %v; want %v", got, back, err, tt.r)
+		}
+	}
+
+	if _, err := (RUT{Number: 1009, DV: 'X'}).MarshalBinary(); !errors.Is(err, ErrInvalidFormat) {
+		t.Errorf("MarshalBinary(bad DV) error = %v; want %v", err, ErrInvalidFormat)
+	}
+}
+
+func TestRUT_UnmarshalBinary(t *testing.T) {
+	tests := []struct {
+		data    []byte
+		wantErr error
+	}{
+		{[]byte{0x15, 0x00, 0xbc, 0x61}, ErrInvalidEncoding},
+		{[]byte{0x25, 0x00, 0xbc, 0x61, 0x4e}, ErrInvalidEncoding},
+		{[]byte{0x1b, 0x00, 0xbc, 0x61, 0x4e}, ErrInvalidEncoding},
+		{[]byte{0x1f, 0x00, 0xbc, 0x61, 0x4e}, ErrInvalidEncoding},
+		{[]byte{0x10, 0x00, 0xbc, 0x61, 0x4e}, ErrInvalidDV},
+	}
+
+	for _, tt := range tests {
+		var got RUT
+		if err := got.UnmarshalBinary(tt.data); !errors.Is(err, tt.wantErr) {
+			t.Errorf("UnmarshalBinary(%x) error = %v; want %v", tt.data, err, tt.wantErr)
+		}
+	}
+}
+
+func TestRUT_Gob(t *testing.T) {
+	type payload struct {
+		Emisor, Receptor RUT
+	}
+	want := payload{Emisor: MustParse("60.803.000-K"), Receptor: MustParse("12.345.678-5")}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(want); err != nil {
+		t.Fatalf("gob Encode() error = %v", err)
+	}
+	var got payload
+	if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+		t.Fatalf("gob Decode() error = %v", err)
+	}
+	if got != want {
+		t.Errorf("gob round trip = %+v; want %+v", got, want)
+	}
+}
+
+func TestRUT_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    RUT
+		wantErr error
+	}{
+		{"12.345.678-5", RUT{Number: 12345678, DV: '5'}, nil},
+		{"1009k", RUT{Number: 1009, DV: 'K'}, nil},
+		{"12.345.678-0", RUT{}, ErrInvalidDV},
+		{"abc", RUT{}, ErrInvalidFormat},
+		{"", RUT{}, nil},
+	}
+
+	for _, tt := range tests {
+		var got RUT
+		err := got.UnmarshalText([]byte(tt.input))
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("UnmarshalText(%q) error = %v; want %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("UnmarshalText(%q) = %v; want %v", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestRUT_YAML(t *testing.T) {
+	v, err := MustParse("60803000-k").MarshalYAML()
+	if err != nil || v != "60.803.000-K" {
+		t.Errorf("MarshalYAML() = %v, %v; want %q, nil", v, err, "60.803.000-K")
+	}
+	if v, err := (RUT{}).MarshalYAML(); err != nil || v != "" {
+		t.Errorf("RUT{}.MarshalYAML() = %v, %v; want %q, nil", v, err, "")
+	}
+
+	tests := []struct {
+		value   any
+		want    RUT
+		wantErr error
+	}{
+		{"12.345.678-5", MustParse("12.345.678-5"), nil},
+		{"12.345.678-0", RUT{}, ErrInvalidDV},
+		{"", RUT{}, nil},
+	}
+	for _, tt := range tests {
+		// unmarshal stands in for the YAML decoder, storing a scalar
+		unmarshal := func(out any) error {
+			*out.(*string) = tt.value.(string)
+			return nil
+		}
+		var got RUT
+		err := got.UnmarshalYAML(unmarshal)
+		if !errors.Is(err, tt.wantErr) || got != tt.want {
+			t.Errorf("UnmarshalYAML(%q) = %v, %v; want %v, %v", tt.value, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestRUT_Pack(t *testing.T) {
+	tests := []RUT{
+		{Number: 1009, DV: 'K'},
+		{Number: 12345678, DV: '5'},
+		{Number: 999999999, DV: CalculateDV(999999999)},
+	}
+
+	for _, r := range tests {
+		v, ok := r.Pack()
+		if got := Unpack(v); !ok || got != r {
+			t.Errorf("Unpack(%v.Pack()) = %v, %v; want %v, true", r, got, ok, r)
+		}
+	}
+	if got, ok := MustParse("12.345.678-5").Pack(); got != 12345678 || !ok {
+		t.Errorf("Pack() = %d, %v; want %d, true", got, ok, 12345678)
+	}
+	for _, n := range []int64{-1, 1 << 32, 5_000_000_000} {
+		if int64(int(n)) != n {
+			continue // Does not fit in int on 32-bit platforms
+		}
+		r := RUT{Number: int(n), DV: '0'}
+		if got, ok := r.Pack(); got != 0 || ok {
+			t.Errorf("RUT{Number: %d}.Pack() = %d, %v; want 0, false", n, got, ok)
+		}
+	}
+}
+
+func TestRUT_JSON(t *testing.T) {
+	type payload struct {
+		RUT RUT `json:"rut"`
+	}
+
+	data, err := json.Marshal(payload{RUT: RUT{Number: 1009, DV: 'K'}})
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if string(data) != `{"rut":"1.009-K"}` {
+		t.Errorf("json.Marshal() = %s; want %s", data, `{"rut":"1.009-K"}`)
+	}
+
+	var got payload
+	if err := json.Unmarshal([]byte(`{"rut":"12345678-5"}`), &got); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if got.RUT != (RUT{Number: 12345678, DV: '5'}) {
+		t.Errorf("json.Unmarshal() = %v; want 12.345.678-5", got.RUT)
+	}
+
+	if err := json.Unmarshal([]byte(`{"rut":"12345678-0"}`), &got); !errors.Is(err, ErrInvalidDV) {
+		t.Errorf("json.Unmarshal() error = %v; want %v", err, ErrInvalidDV)
+	}
+
+	// The zero RUT round trips as an empty string
+	data, err = json.Marshal(payload{})
+	if err != nil || string(data) != `{"rut":""}` {
+		t.Errorf("json.Marshal(zero) = %s, %v; want %s", data, err, `{"rut":""}`)
+	}
+	if err := json.Unmarshal(data, &got); err != nil || !got.RUT.IsZero() {
+		t.Errorf("json.Unmarshal(%s) = %v, %v; want the zero RUT", data, got.RUT, err)
+	}
+}
@@ -0,0 +1,133 @@
+package rut
+
+import (
+	"errors"
+	"strconv"
+)
+
+// ErrorCode identifies why an input was rejected, for API clients and
+// form validators that need more detail than the package errors.
+type ErrorCode string
+
+// Error codes of a ParseError.
+const (
+	CodeEmpty             ErrorCode = "empty"              // ErrEmptyRUT
+	CodeTooShort          ErrorCode = "too_short"          // ErrTooShort
+	CodeTooLong           ErrorCode = "too_long"           // ErrTooLong
+	CodeInvalidChar       ErrorCode = "invalid_char"       // ErrInvalidFormat: not a digit, K or separator
+	CodeMisplacedK        ErrorCode = "misplaced_k"        // ErrInvalidFormat: K before the check digit
+	CodeInvalidSeparators ErrorCode = "invalid_separators" // ErrInvalidFormat: style not accepted by a Parser
+	CodeInvalidParts      ErrorCode = "invalid_parts"      // ErrInvalidFormat: bad FromParts arguments
+	CodeInvalidDV         ErrorCode = "invalid_dv"         // ErrInvalidDV
+	CodeInvalidFormat     ErrorCode = "invalid_format"     // ErrInvalidFormat without more detail
+
+	// Codes of the errors returned outside of parsing, see CodeOf.
+	CodeRequired       ErrorCode = "required"         // ErrRequired
+	CodeNotPerson      ErrorCode = "not_person"       // ErrNotPerson
+	CodeNotCompany     ErrorCode = "not_company"      // ErrNotCompany
+	CodeOutOfRange     ErrorCode = "out_of_range"     // ErrOutOfRange
+	CodeKindNotAllowed ErrorCode = "kind_not_allowed" // ErrKindNotAllowed
+	CodeDenied         ErrorCode = "denied"           // ErrDenied
+	CodeFictitious     ErrorCode = "fictitious"       // ErrFictitious
+	CodeReserved       ErrorCode = "reserved"         // ErrReserved
+)
+
+// ParseError describes an input rejected by Parse, ParseBytes, ParseStrict,
+// FromParts, UnmarshalText or a Parser. It wraps one of the package
+// errors, so errors.Is(err, ErrInvalidFormat) and similar checks keep
+// working:
+//
+//	var perr *rut.ParseError
+//	if errors.As(err, &perr) {
+//		fmt.Println(perr.Code, perr.Pos) // invalid_char 2
+//	}
+//
+// The message does not include the input, which is personal data.
+type ParseError struct {
+	Input string    // The rejected input
+	Pos   int       // Byte offset of the offending character in Input, or -1
+	Code  ErrorCode // Why the input was rejected
+	Err   error     // The package error, such as ErrInvalidFormat
+	Limit int       // Length bound broken, for CodeTooShort and CodeTooLong
+}
+
+func (e *ParseError) Error() string {
+	msg := e.Err.Error()
+	switch {
+	case e.Limit > 0 && e.Code == CodeTooShort:
+		msg += " (minimum " + strconv.Itoa(e.Limit) + " characters)"
+	case e.Limit > 0 && e.Code == CodeTooLong:
+		msg += " (maximum " + strconv.Itoa(e.Limit) + " characters)"
+	}
+	if e.Pos < 0 {
+		return msg
+	}
+	return msg + " at byte " + strconv.Itoa(e.Pos)
+}
+
+// Unwrap returns the package error.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// codeErrors maps error codes to package errors.
+var codeErrors = map[ErrorCode]error{
+	CodeEmpty:             ErrEmptyRUT,
+	CodeTooShort:          ErrTooShort,
+	CodeTooLong:           ErrTooLong,
+	CodeInvalidChar:       ErrInvalidFormat,
+	CodeMisplacedK:        ErrInvalidFormat,
+	CodeInvalidSeparators: ErrInvalidFormat,
+	CodeInvalidParts:      ErrInvalidFormat,
+	CodeInvalidDV:         ErrInvalidDV,
+}
+
+// sentinelCodes maps package errors to codes, for CodeOf.
+var sentinelCodes = []struct {
+	err  error
+	code ErrorCode
+}{
+	{ErrEmptyRUT, CodeEmpty},
+	{ErrTooShort, CodeTooShort},
+	{ErrTooLong, CodeTooLong},
+	{ErrInvalidFormat, CodeInvalidFormat},
+	{ErrInvalidDV, CodeInvalidDV},
+	{ErrRequired, CodeRequired},
+	{ErrNotPerson, CodeNotPerson},
+	{ErrNotCompany, CodeNotCompany},
+	{ErrOutOfRange, CodeOutOfRange},
+	{ErrKindNotAllowed, CodeKindNotAllowed},
+	{ErrDenied, CodeDenied},
+	{ErrFictitious, CodeFictitious},
+	{ErrReserved, CodeReserved},
+}
+
+// CodeOf returns the code of err: the code of a wrapped *ParseError, or the
+// code of the first package error err wraps. It returns "" for other
+// errors.
+func CodeOf(err error) ErrorCode {
+	var perr *ParseError
+	if errors.As(err, &perr) {
+		return perr.Code
+	}
+	for _, sc := range sentinelCodes {
+		if errors.Is(err, sc.err) {
+			return sc.code
+		}
+	}
+	return ""
+}
+
+func newParseError[T string | []byte](input T, pos int, code ErrorCode) *ParseError {
+	return &ParseError{Input: string(input), Pos: pos, Code: code, Err: codeErrors[code]}
+}
+
+// dvError returns the error for a well formed input whose check digit does
+// not match, pointing at the check digit.
+func dvError[T string | []byte](input T) *ParseError {
+	pos := len(input) - 1
+	for pos >= 0 && isSeparator(input[pos]) {
+		pos--
+	}
+	return newParseError(input, pos, CodeInvalidDV)
+}
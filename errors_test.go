@@ -0,0 +1,105 @@
+package rut
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		input   string
+		code    ErrorCode
+		pos     int
+		wantErr error
+	}{
+		{"", CodeEmpty, -1, ErrEmptyRUT},
+		{"1-9", CodeTooShort, -1, ErrTooShort},
+		{"12345678901", CodeTooLong, 10, ErrTooLong},
+		{"12.3a5.678-5", CodeInvalidChar, 4, ErrInvalidFormat},
+		{"12.34K.678-5", CodeMisplacedK, 5, ErrInvalidFormat},
+		{"1K.K45.678-5", CodeMisplacedK, 1, ErrInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		_, err := Parse(tt.input)
+		var perr *ParseError
+		if !errors.As(err, &perr) {
+			t.Errorf("Parse(%q) error = %v; want a *ParseError", tt.input, err)
+			continue
+		}
+		if perr.Input != tt.input || perr.Code != tt.code || perr.Pos != tt.pos || !errors.Is(err, tt.wantErr) {
+			t.Errorf("Parse(%q) error = %+v; want code %s at %d wrapping %v", tt.input, perr, tt.code, tt.pos, tt.wantErr)
+		}
+	}
+}
+
+func TestParseError_DV(t *testing.T) {
+	check := func(name string, err error, input string, pos int) {
+		t.Helper()
+		var perr *ParseError
+		if !errors.As(err, &perr) || perr.Code != CodeInvalidDV || perr.Pos != pos || perr.Input != input || !errors.Is(err, ErrInvalidDV) {
+			t.Errorf("%s error = %#v; want %s at %d", name, err, CodeInvalidDV, pos)
+		}
+	}
+
+	_, err := ParseStrict("12.345.678-0 ")
+	check("ParseStrict", err, "12.345.678-0 ", 11)
+
+	var r RUT
+	check("UnmarshalText", r.UnmarshalText([]byte("12345678-0")), "12345678-0", 9)
+
+	_, err = NewParser(WithVerifyDV()).Parse("123456780")
+	check("Parser.Parse", err, "123456780", 8)
+}
+
+func TestParseError_Parser(t *testing.T) {
+	_, err := NewParser(WithStyles(FormatWithDash)).Parse("12.345.678-5")
+	var perr *ParseError
+	if !errors.As(err, &perr) || perr.Code != CodeInvalidSeparators || !errors.Is(err, ErrInvalidFormat) {
+		t.Errorf("Parse() error = %#v; want %s", err, CodeInvalidSeparators)
+	}
+
+	// Positions refer to the rewritten input, so they are dropped
+	input := "RUT: 12.3a5.678-5"
+	_, err = NewParser(WithLabels()).Parse(input)
+	if !errors.As(err, &perr) || perr.Input != input || perr.Pos != -1 || perr.Code != CodeInvalidChar {
+		t.Errorf("Parse(%q) error = %#v; want %s at -1", input, err, CodeInvalidChar)
+	}
+}
+
+func TestParseError_Error(t *testing.T) {
+	_, err := Parse("12.3a5.678-5")
+	if got, want := err.Error(), "rut: invalid format at byte 4"; got != want {
+		t.Errorf("Error() = %q; want %q", got, want)
+	}
+	_, err = Parse("")
+	if got, want := err.Error(), ErrEmptyRUT.Error(); got != want {
+		t.Errorf("Error() = %q; want %q", got, want)
+	}
+
+	tests := []struct {
+		p    *Parser
+		in   string
+		want string
+	}{
+		{NewParser(), "1-9", "rut: too short (minimum 5 characters)"},
+		{NewParser(), "12.345.678.901-5", "rut: too long (maximum 10 characters) at byte 13"},
+		{NewParser(WithLength(8, 9)), "1.009-K", "rut: too short (minimum 8 characters)"},
+		{NewParser(WithLength(2, 8)), "123.456.789-5", "rut: too long (maximum 8 characters) at byte 10"},
+	}
+	for _, tt := range tests {
+		_, err := tt.p.Parse(tt.in)
+		var perr *ParseError
+		if err == nil || err.Error() != tt.want || !errors.As(err, &perr) || perr.Limit == 0 {
+			t.Errorf("Parse(%q) error = %v; want %q with a Limit", tt.in, err, tt.want)
+		}
+	}
+}
+
+func TestFromParts_ParseError(t *testing.T) {
+	_, err := FromParts("12.345.678", "55")
+	var perr *ParseError
+	if !errors.As(err, &perr) || perr.Code != CodeInvalidParts || !errors.Is(err, ErrInvalidFormat) {
+		t.Errorf("FromParts() error = %#v; want %s", err, CodeInvalidParts)
+	}
+}
@@ -0,0 +1,217 @@
+package rut
+
+import (
+	"bufio"
+	"bytes"
+	"container/heap"
+	"io"
+	"os"
+	"slices"
+)
+
+// defaultChunkSize is the number of RUTs SortDedup holds in memory before
+// spilling them to a temporary file, about 16 MB.
+const defaultChunkSize = 1 << 20
+
+// SortOptions configures SortDedup. The zero value is ready to use.
+type SortOptions struct {
+	ChunkSize int         // RUTs held in memory before spilling, 0 for the default
+	TempDir   string      // Directory for spill files, "" for os.TempDir
+	Style     FormatStyle // Output format, FormatComplete by default
+}
+
+// SortStats reports what SortDedup did.
+type SortStats struct {
+	Read       int // Non-blank input lines
+	Written    int // RUTs in the output
+	Invalid    int // Lines skipped because they are not valid RUTs
+	Duplicates int // RUTs skipped because they were already written
+	Spills     int // Temporary files used
+}
+
+// SortDedup reads RUTs from src, one per line in any format accepted by
+// Parse, and writes them to dst in ascending order without duplicates, one
+// per line in opts.Style. Surrounding whitespace, including the \r of CRLF
+// line endings, is trimmed, blank lines are ignored and lines that are not
+// valid RUTs are skipped and counted in SortStats.Invalid.
+//
+// Inputs larger than opts.ChunkSize are sorted in chunks spilled to
+// temporary files in the delta encoding of NewDeltaEncoder, then merged, so
+// memory use is bounded regardless of the input size. Spill files are
+// removed before returning.
+func SortDedup(dst io.Writer, src io.Reader, opts SortOptions) (SortStats, error) {
+	if opts.ChunkSize <= 0 {
+		opts.ChunkSize = defaultChunkSize
+	}
+
+	var stats SortStats
+	var spills []*os.File
+	defer func() {
+		for _, f := range spills {
+			f.Close()
+			os.Remove(f.Name())
+		}
+	}()
+
+	chunk := make([]RUT, 0, min(opts.ChunkSize, 4096))
+	sc := bufio.NewScanner(src)
+	for sc.Scan() {
+		line := bytes.TrimSpace(sc.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		stats.Read++
+		r, err := ParseBytes(line)
+		if err != nil || !r.Validate() {
+			stats.Invalid++
+			continue
+		}
+
+		chunk = append(chunk, r)
+		if len(chunk) == opts.ChunkSize {
+			f, err := spill(chunk, opts.TempDir)
+			if err != nil {
+				return stats, err
+			}
+			spills = append(spills, f)
+			chunk = chunk[:0]
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return stats, err
+	}
+
+	w := bufio.NewWriter(dst)
+	out := sortWriter{w: w, style: opts.Style, stats: &stats}
+	if len(spills) == 0 {
+		slices.SortFunc(chunk, Compare)
+		for _, r := range chunk {
+			out.write(r)
+		}
+	} else {
+		if len(chunk) > 0 {
+			f, err := spill(chunk, opts.TempDir)
+			if err != nil {
+				return stats, err
+			}
+			spills = append(spills, f)
+		}
+		stats.Spills = len(spills)
+		if err := merge(spills, out.write); err != nil {
+			return stats, err
+		}
+	}
+	stats.Duplicates = stats.Read - stats.Invalid - stats.Written
+	return stats, w.Flush()
+}
+
+// sortWriter writes sorted RUTs to w, skipping duplicates.
+type sortWriter struct {
+	w     *bufio.Writer
+	style FormatStyle
+	stats *SortStats
+	prev  int
+	buf   []byte
+}
+
+func (s *sortWriter) write(r RUT) {
+	if s.stats.Written > 0 && r.Number == s.prev {
+		return
+	}
+	s.prev = r.Number
+	s.stats.Written++
+	s.buf = append(r.AppendFormat(s.buf[:0], s.style), '\n')
+	s.w.Write(s.buf) // Errors are reported by Flush
+}
+
+// spill sorts chunk and writes it to a new temporary file, rewound for
+// reading.
+func spill(chunk []RUT, dir string) (*os.File, error) {
+	slices.SortFunc(chunk, Compare)
+	chunk = slices.Compact(chunk)
+
+	f, err := os.CreateTemp(dir, "rut-sort-*")
+	if err != nil {
+		return nil, err
+	}
+	e := NewDeltaEncoder(f)
+	for _, r := range chunk {
+		if err = e.Encode(r); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = e.Close()
+	}
+	if err == nil {
+		_, err = f.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return nil, err
+	}
+	return f, nil
+}
+
+// merge calls fn with the RUTs of the sorted spill files in ascending order.
+func merge(files []*os.File, fn func(RUT)) error {
+	h := make(mergeHeap, 0, len(files))
+	for _, f := range files {
+		src := mergeSource{d: NewDeltaDecoder(f)}
+		ok, err := src.next()
+		if err != nil {
+			return err
+		}
+		if ok {
+			h = append(h, src)
+		}
+	}
+	heap.Init(&h)
+
+	for len(h) > 0 {
+		fn(h[0].head)
+		ok, err := h[0].next()
+		if err != nil {
+			return err
+		}
+		if ok {
+			heap.Fix(&h, 0)
+		} else {
+			heap.Pop(&h)
+		}
+	}
+	return nil
+}
+
+type mergeSource struct {
+	d    *DeltaDecoder
+	head RUT
+}
+
+// next advances to the next RUT, returning false at the end of the file.
+func (m *mergeSource) next() (bool, error) {
+	r, err := m.d.Next()
+	if err == io.EOF {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	m.head = r
+	return true, nil
+}
+
+type mergeHeap []mergeSource
+
+func (h mergeHeap) Len() int           { return len(h) }
+func (h mergeHeap) Less(i, j int) bool { return h[i].head.Number < h[j].head.Number }
+func (h mergeHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+func (h *mergeHeap) Push(x any)        { *h = append(*h, x.(mergeSource)) }
+
+func (h *mergeHeap) Pop() any {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
@@ -0,0 +1,85 @@
+package rut
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestSortDedup(t *testing.T) {
+	input := strings.Join([]string{
+		"12.345.678-5",
+		"1009-k",
+		"",
+		"12345678-5",
+		"not a rut",
+		"12.345.678-6",
+		"7.654.321-6",
+		"1.009-K",
+	}, "\n")
+	want := "1.009-K\n7.654.321-6\n12.345.678-5\n"
+	wantStats := SortStats{Read: 7, Written: 3, Invalid: 2, Duplicates: 2}
+
+	var out bytes.Buffer
+	stats, err := SortDedup(&out, strings.NewReader(input), SortOptions{})
+	if err != nil {
+		t.Fatalf("SortDedup() error = %v", err)
+	}
+	if out.String() != want {
+		t.Errorf("SortDedup() output = %q; want %q", out.String(), want)
+	}
+	if stats != wantStats {
+		t.Errorf("SortDedup() stats = %+v; want %+v", stats, wantStats)
+	}
+}
+
+func TestSortDedup_CRLF(t *testing.T) {
+	input := "12.345.678-5\r\n   \r\n\t\r\n 1.009-K \r\n\r\n"
+	want := "1.009-K\n12.345.678-5\n"
+	wantStats := SortStats{Read: 2, Written: 2}
+
+	var out bytes.Buffer
+	stats, err := SortDedup(&out, strings.NewReader(input), SortOptions{})
+	if err != nil {
+		t.Fatalf("SortDedup() error = %v", err)
+	}
+	if out.String() != want {
+		t.Errorf("SortDedup() output = %q; want %q", out.String(), want)
+	}
+	if stats != wantStats {
+		t.Errorf("SortDedup() stats = %+v; want %+v", stats, wantStats)
+	}
+}
+
+func TestSortDedup_Spill(t *testing.T) {
+	// Numbers in descending order, each repeated, across several chunks
+	var input, want strings.Builder
+	for n := 20_000; n > 10_000; n -= 7 {
+		r := RUT{Number: n, DV: CalculateDV(n)}
+		fmt.Fprintf(&input, "%s\n%s\n", r.Format(FormatWithDash), r.Format(FormatEscaped))
+	}
+	for n := 20_000 - 1428*7; n <= 20_000; n += 7 {
+		r := RUT{Number: n, DV: CalculateDV(n)}
+		fmt.Fprintf(&want, "%s\n", r.Format(FormatWithDash))
+	}
+
+	dir := t.TempDir()
+	var out bytes.Buffer
+	opts := SortOptions{ChunkSize: 500, TempDir: dir, Style: FormatWithDash}
+	stats, err := SortDedup(&out, strings.NewReader(input.String()), opts)
+	if err != nil {
+		t.Fatalf("SortDedup() error = %v", err)
+	}
+	if out.String() != want.String() {
+		t.Errorf("SortDedup() output differs from the sorted input")
+	}
+	if stats.Written != 1429 || stats.Duplicates != 1429 || stats.Spills != 6 {
+		t.Errorf("SortDedup() stats = %+v; want 1429 written, 1429 duplicates, 6 spills", stats)
+	}
+
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("SortDedup() left %d spill files", len(entries))
+	}
+}
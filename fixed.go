@@ -0,0 +1,202 @@
+package rut
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// Fixed width file errors
+var (
+	ErrInvalidSchema = errors.New("rut: invalid fixed width schema")
+	ErrShortLine     = errors.New("rut: line shorter than schema")
+	ErrFieldTooLong  = errors.New("rut: value longer than field")
+)
+
+// FixedField is a column of a fixed width file, as found in legacy ERP and
+// bank interfaces.
+type FixedField struct {
+	Name   string
+	Offset int         // Position of the first byte, from 0
+	Width  int         // Width in bytes
+	Pad    byte        // Padding byte, ' ' if 0
+	Right  bool        // Right aligned, padded on the left, as numbers usually are
+	RUT    bool        // The field holds a RUT, validated when read and written
+	Style  FormatStyle // Style of RUT fields when written
+}
+
+func (f FixedField) pad() byte {
+	if f.Pad == 0 {
+		return ' '
+	}
+	return f.Pad
+}
+
+// FixedSchema is the layout of the records of a fixed width file. Fields
+// may be listed in any order and gaps between them are written as spaces.
+type FixedSchema []FixedField
+
+// Width returns the length of a record, up to the end of the last field.
+func (s FixedSchema) Width() int {
+	w := 0
+	for _, f := range s {
+		w = max(w, f.Offset+f.Width)
+	}
+	return w
+}
+
+// Validate reports ErrInvalidSchema if a field has a negative offset, a
+// width below 1, or overlaps another field.
+func (s FixedSchema) Validate() error {
+	for i, f := range s {
+		if f.Offset < 0 || f.Width < 1 {
+			return fmt.Errorf("%w: field %q", ErrInvalidSchema, f.Name)
+		}
+		for _, g := range s[:i] {
+			if f.Offset < g.Offset+g.Width && g.Offset < f.Offset+f.Width {
+				return fmt.Errorf("%w: fields %q and %q overlap", ErrInvalidSchema, g.Name, f.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// FixedReader reads the records of a fixed width file, one per line.
+type FixedReader struct {
+	schema FixedSchema
+	width  int
+	err    error // From schema.Validate
+	sc     *bufio.Scanner
+	line   int
+}
+
+// NewFixedReader returns a reader of records laid out as in schema. The
+// schema is copied and validated once; if it is invalid, every Read
+// returns the validation error.
+func NewFixedReader(r io.Reader, schema FixedSchema) *FixedReader {
+	schema = slices.Clone(schema)
+	return &FixedReader{schema: schema, width: schema.Width(), err: schema.Validate(), sc: bufio.NewScanner(r)}
+}
+
+// Read returns the values of the next record, in the order of the schema
+// fields and without padding, or io.EOF at the end of the input. Fields
+// holding only padding, such as a zero padded 0, read as "". Blank lines
+// are skipped and a trailing carriage return is ignored.
+//
+// RUT fields are checked with ParseStrict. If one is not a valid RUT, Read
+// returns the record along with an error wrapping a *FieldError, so callers
+// can report it and go on reading. Lines too short for the schema return
+// ErrShortLine.
+func (r *FixedReader) Read() ([]string, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	var line []byte
+	for {
+		if !r.sc.Scan() {
+			if err := r.sc.Err(); err != nil {
+				return nil, err
+			}
+			return nil, io.EOF
+		}
+		r.line++
+		line = bytes.TrimSuffix(r.sc.Bytes(), []byte("\r"))
+		if len(bytes.TrimSpace(line)) > 0 {
+			break
+		}
+	}
+	if len(line) < r.width {
+		return nil, fmt.Errorf("rut: line %d: %w", r.line, ErrShortLine)
+	}
+
+	record := make([]string, len(r.schema))
+	var errs []error
+	for i, f := range r.schema {
+		v := string(line[f.Offset : f.Offset+f.Width])
+		if f.Right {
+			v = strings.TrimLeft(v, string(f.pad()))
+		} else {
+			v = strings.TrimRight(v, string(f.pad()))
+		}
+		record[i] = v
+
+		if f.RUT && strings.TrimSpace(v) != "" {
+			if _, err := ParseStrict(strings.TrimSpace(v)); err != nil {
+				errs = append(errs, &FieldError{Field: f.Name, Err: err})
+			}
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return record, fmt.Errorf("rut: line %d: %w", r.line, err)
+	}
+	return record, nil
+}
+
+// FixedWriter writes records to a fixed width file, one per line.
+type FixedWriter struct {
+	schema  FixedSchema
+	width   int
+	err     error // From schema.Validate
+	w       *bufio.Writer
+	UseCRLF bool // End lines with \r\n instead of \n
+}
+
+// NewFixedWriter returns a writer of records laid out as in schema. Call
+// Flush to write buffered data. The schema is copied and validated once;
+// if it is invalid, every Write returns the validation error.
+func NewFixedWriter(w io.Writer, schema FixedSchema) *FixedWriter {
+	schema = slices.Clone(schema)
+	return &FixedWriter{schema: schema, width: schema.Width(), err: schema.Validate(), w: bufio.NewWriter(w)}
+}
+
+// Write writes a record holding one value per schema field, in the order of
+// the schema. RUT fields accept any format supported by Parse, must have a
+// valid check digit and are written in the field Style; empty RUT fields
+// are written as padding. Values longer than their field return
+// ErrFieldTooLong. Nothing is written if the record is rejected.
+func (w *FixedWriter) Write(record []string) error {
+	if w.err != nil {
+		return w.err
+	}
+	if len(record) != len(w.schema) {
+		return fmt.Errorf("rut: record has %d values, schema %d fields", len(record), len(w.schema))
+	}
+
+	line := bytes.Repeat([]byte{' '}, w.width)
+	for i, f := range w.schema {
+		v := record[i]
+		if f.RUT && v != "" {
+			r, err := ParseStrict(v)
+			if err != nil {
+				return &FieldError{Field: f.Name, Err: err}
+			}
+			v = r.Format(f.Style)
+		}
+		if len(v) > f.Width {
+			return &FieldError{Field: f.Name, Err: ErrFieldTooLong}
+		}
+
+		padding := bytes.Repeat([]byte{f.pad()}, f.Width-len(v))
+		field := line[f.Offset : f.Offset+f.Width]
+		if f.Right {
+			copy(field[copy(field, padding):], v)
+		} else {
+			copy(field[copy(field, v):], padding)
+		}
+	}
+
+	if w.UseCRLF {
+		line = append(line, '\r')
+	}
+	_, err := w.w.Write(append(line, '\n'))
+	return err
+}
+
+// Flush writes buffered data to the underlying writer.
+func (w *FixedWriter) Flush() error {
+	return w.w.Flush()
+}
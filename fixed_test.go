@@ -0,0 +1,145 @@
+package rut
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var testSchema = FixedSchema{
+	{Name: "rut", Offset: 0, Width: 10, Pad: '0', Right: true, RUT: true, Style: FormatEscaped},
+	{Name: "nombre", Offset: 11, Width: 8},
+	{Name: "monto", Offset: 19, Width: 6, Pad: '0', Right: true},
+}
+
+func TestFixedWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewFixedWriter(&buf, testSchema)
+	records := [][]string{
+		{"12.345.678-5", "Ana", "1500"},
+		{"1.009-k", "Luis", "20"},
+		{"", "Eva", "0"},
+	}
+	for _, rec := range records {
+		if err := w.Write(rec); err != nil {
+			t.Fatalf("Write(%q) error = %v", rec, err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "" +
+		"0123456785 Ana     001500\n" +
+		"000001009K Luis    000020\n" +
+		"0000000000 Eva     000000\n"
+	if got := buf.String(); got != want {
+		t.Errorf("output = %q; want %q", got, want)
+	}
+}
+
+func TestFixedWriter_Errors(t *testing.T) {
+	tests := []struct {
+		record []string
+		want   error
+	}{
+		{[]string{"12.345.678-0", "Ana", "1"}, ErrInvalidDV},
+		{[]string{"12.345.678-5", "Maximiliano", "1"}, ErrFieldTooLong},
+		{[]string{"12.345.678-5", "Ana"}, nil},
+	}
+
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		w := NewFixedWriter(&buf, testSchema)
+		err := w.Write(tt.record)
+		if err == nil || tt.want != nil && !errors.Is(err, tt.want) {
+			t.Errorf("Write(%q) error = %v; want %v", tt.record, err, tt.want)
+		}
+		w.Flush()
+		if buf.Len() != 0 {
+			t.Errorf("Write(%q) wrote %q; want nothing", tt.record, buf.String())
+		}
+	}
+}
+
+func TestFixedWriter_CRLF(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewFixedWriter(&buf, FixedSchema{{Name: "rut", Width: 12, RUT: true, Style: FormatComplete}})
+	w.UseCRLF = true
+	w.Write([]string{"123456785"})
+	w.Flush()
+	if got, want := buf.String(), "12.345.678-5\r\n"; got != want {
+		t.Errorf("output = %q; want %q", got, want)
+	}
+}
+
+func TestFixedReader(t *testing.T) {
+	input := "" +
+		"0123456785 Ana     001500\r\n" +
+		"\n" +
+		"0123456780 Eva     000020\n" +
+		"000001009K Luis    000020\n" +
+		"000001009K Luis\n"
+	r := NewFixedReader(strings.NewReader(input), testSchema)
+
+	rec, err := r.Read()
+	if want := []string{"123456785", "Ana", "1500"}; err != nil || !reflect.DeepEqual(rec, want) {
+		t.Errorf("Read() = %q, %v; want %q, nil", rec, err, want)
+	}
+
+	rec, err = r.Read()
+	var ferr *FieldError
+	if !errors.As(err, &ferr) || ferr.Field != "rut" || !errors.Is(err, ErrInvalidDV) || !strings.Contains(err.Error(), "line 3") {
+		t.Errorf("Read() error = %v; want invalid DV in field rut on line 3", err)
+	}
+	if want := []string{"123456780", "Eva", "20"}; !reflect.DeepEqual(rec, want) {
+		t.Errorf("Read() = %q; want %q", rec, want)
+	}
+
+	rec, err = r.Read()
+	if want := []string{"1009K", "Luis", "20"}; err != nil || !reflect.DeepEqual(rec, want) {
+		t.Errorf("Read() = %q, %v; want %q, nil", rec, err, want)
+	}
+
+	if _, err = r.Read(); !errors.Is(err, ErrShortLine) {
+		t.Errorf("Read() error = %v; want ErrShortLine", err)
+	}
+	if _, err = r.Read(); err != io.EOF {
+		t.Errorf("Read() at end = %v; want io.EOF", err)
+	}
+}
+
+func TestFixedSchema_Validate(t *testing.T) {
+	tests := []struct {
+		schema FixedSchema
+		want   error
+	}{
+		{testSchema, nil},
+		{FixedSchema{{Name: "a", Width: 0}}, ErrInvalidSchema},
+		{FixedSchema{{Name: "a", Offset: -1, Width: 2}}, ErrInvalidSchema},
+		{FixedSchema{{Name: "a", Width: 5}, {Name: "b", Offset: 4, Width: 2}}, ErrInvalidSchema},
+	}
+
+	for _, tt := range tests {
+		if err := tt.schema.Validate(); !errors.Is(err, tt.want) {
+			t.Errorf("%v.Validate() = %v; want %v", tt.schema, err, tt.want)
+		}
+		if tt.want == nil {
+			continue
+		}
+		r := NewFixedReader(strings.NewReader("12345678-5\n"), tt.schema)
+		if _, err := r.Read(); !errors.Is(err, tt.want) {
+			t.Errorf("Read() with schema %v error = %v; want %v", tt.schema, err, tt.want)
+		}
+		w := NewFixedWriter(io.Discard, tt.schema)
+		if err := w.Write([]string{"a", "b"}); !errors.Is(err, tt.want) {
+			t.Errorf("Write() with schema %v error = %v; want %v", tt.schema, err, tt.want)
+		}
+	}
+	if got := testSchema.Width(); got != 25 {
+		t.Errorf("Width() = %d; want 25", got)
+	}
+}
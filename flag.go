@@ -0,0 +1,47 @@
+package rut
+
+import "flag"
+
+// flagValue implements flag.Value for a RUT.
+type flagValue struct {
+	p *RUT
+}
+
+// NewFlagValue returns a flag.Value that stores into p, for registering
+// RUT flags on a flag.FlagSet:
+//
+//	var emisor rut.RUT
+//	fs.Var(rut.NewFlagValue(&emisor), "emisor", "issuer RUT")
+//
+// Values are validated like UnmarshalText, so a wrong check digit fails
+// flag parsing with ErrInvalidDV.
+func NewFlagValue(p *RUT) flag.Value {
+	return flagValue{p: p}
+}
+
+// String implements flag.Value, printing nothing for an unset flag.
+func (f flagValue) String() string {
+	if f.p == nil || f.p.IsZero() {
+		return ""
+	}
+	return f.p.String()
+}
+
+// Set implements flag.Value.
+func (f flagValue) Set(s string) error {
+	return f.p.UnmarshalText([]byte(s))
+}
+
+// Flag defines a RUT flag with the given name and usage on the default
+// command line flag set, and returns a pointer to its value, which is the
+// zero RUT until the flag is set.
+func Flag(name, usage string) *RUT {
+	p := new(RUT)
+	FlagVar(p, name, usage)
+	return p
+}
+
+// FlagVar is like Flag but stores the value in p.
+func FlagVar(p *RUT, name, usage string) {
+	flag.Var(NewFlagValue(p), name, usage)
+}
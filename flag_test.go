@@ -0,0 +1,53 @@
+package rut
+
+import (
+	"errors"
+	"flag"
+	"io"
+	"testing"
+)
+
+func TestFlagValue(t *testing.T) {
+	tests := []struct {
+		arg     string
+		want    RUT
+		wantErr error
+	}{
+		{"12.345.678-5", MustParse("12.345.678-5"), nil},
+		{"1009k", MustParse("1.009-K"), nil},
+		{"12.345.678-0", RUT{}, ErrInvalidDV},
+		{"abc", RUT{}, ErrInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		var got RUT
+		err := NewFlagValue(&got).Set(tt.arg)
+		if !errors.Is(err, tt.wantErr) || got != tt.want {
+			t.Errorf("Set(%q) = %v, %v; want %v, %v", tt.arg, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestFlagValue_FlagSet(t *testing.T) {
+	var got RUT
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.Var(NewFlagValue(&got), "rut", "")
+
+	if err := fs.Parse([]string{"-rut", "60803000-k"}); err != nil || got != MustParse("60.803.000-K") {
+		t.Errorf("Parse(-rut 60803000-k) = %v, %v; want 60.803.000-K, nil", got, err)
+	}
+	if err := fs.Parse([]string{"-rut", "60803000-1"}); err == nil {
+		t.Errorf("Parse(-rut 60803000-1) error = nil; want an error")
+	}
+}
+
+func TestFlagValue_String(t *testing.T) {
+	r := MustParse("1009-k")
+	if got := NewFlagValue(&r).String(); got != "1.009-K" {
+		t.Errorf("String() = %q; want %q", got, "1.009-K")
+	}
+	if got := NewFlagValue(new(RUT)).String(); got != "" {
+		t.Errorf("String() of unset flag = %q; want empty", got)
+	}
+}
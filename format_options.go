@@ -0,0 +1,96 @@
+package rut
+
+import (
+	"strconv"
+	"unicode/utf8"
+)
+
+// FormatOptions describes a custom format for FormatWith, for layouts not
+// covered by the predefined styles. The zero value formats like
+// FormatEscaped.
+type FormatOptions struct {
+	Separator rune // Thousands separator, 0 for none
+	DashRune  rune // Placed between number and check digit, 0 for none
+	Lowercase bool // Print 'k' instead of 'K'
+	PadWidth  int  // Left pad the number with zeros to this many digits
+	OmitDV    bool // Print the number only, without dash and check digit
+}
+
+// Options returns the FormatOptions equivalent to the style, as a starting
+// point for variations such as the lowercase check digit required by some
+// legacy systems:
+//
+//	opts := rut.FormatWithDash.Options()
+//	opts.Lowercase = true
+//	r.FormatWith(opts) // "1009-k"
+//
+// Parsing is case insensitive, so such output parses back to the same RUT.
+func (s FormatStyle) Options() FormatOptions {
+	switch s {
+	case FormatEscaped:
+		return FormatOptions{}
+	case FormatWithDash:
+		return FormatOptions{DashRune: '-'}
+	case FormatSpaces:
+		return FormatOptions{Separator: ' ', DashRune: '-'}
+	case FormatNumberOnly:
+		return FormatOptions{OmitDV: true}
+	case FormatNumberDots:
+		return FormatOptions{Separator: '.', OmitDV: true}
+	default:
+		return FormatOptions{Separator: '.', DashRune: '-'}
+	}
+}
+
+// FormatWith normalizes and formats a RUT string according to opts.
+func FormatWith(s string, opts FormatOptions) (string, error) {
+	r, err := Parse(s)
+	if err != nil {
+		return "", err
+	}
+	return r.FormatWith(opts), nil
+}
+
+// FormatWith returns the RUT formatted according to opts.
+func (r RUT) FormatWith(opts FormatOptions) string {
+	var buf [32]byte
+	return string(r.AppendFormatWith(buf[:0], opts))
+}
+
+// AppendFormatWith is like FormatWith but appends to dst and returns the
+// extended buffer.
+func (r RUT) AppendFormatWith(dst []byte, opts FormatOptions) []byte {
+	var buf [20]byte
+	digits := strconv.AppendInt(buf[:0], int64(r.Number), 10)
+
+	n := len(digits)
+	if opts.PadWidth > n {
+		n = opts.PadWidth
+	}
+	for i := 0; i < n; i++ {
+		// Zero padding precedes the digits
+		pad := n - len(digits)
+		if i < pad {
+			dst = append(dst, '0')
+		} else {
+			dst = append(dst, digits[i-pad])
+		}
+		// Add separators from right to left every 3 digits
+		distFromEnd := n - i - 1
+		if opts.Separator != 0 && distFromEnd > 0 && distFromEnd%3 == 0 {
+			dst = utf8.AppendRune(dst, opts.Separator)
+		}
+	}
+
+	if opts.OmitDV {
+		return dst
+	}
+	if opts.DashRune != 0 {
+		dst = utf8.AppendRune(dst, opts.DashRune)
+	}
+	dv := r.DV
+	if opts.Lowercase && dv == 'K' {
+		dv = 'k'
+	}
+	return append(dst, dv)
+}
@@ -0,0 +1,76 @@
+package rut
+
+import (
+	"testing"
+)
+
+func TestRUT_FormatWith(t *testing.T) {
+	tests := []struct {
+		name     string
+		r        RUT
+		opts     FormatOptions
+		expected string
+	}{
+		{"zero value", RUT{Number: 12345678, DV: '5'}, FormatOptions{}, "123456785"},
+		{"complete", RUT{Number: 12345678, DV: '5'}, FormatOptions{Separator: '.', DashRune: '-'}, "12.345.678-5"},
+		{"spaces", RUT{Number: 12345678, DV: '5'}, FormatOptions{Separator: ' ', DashRune: '-'}, "12 345 678-5"},
+		{"unicode", RUT{Number: 12345678, DV: '5'}, FormatOptions{Separator: ' ', DashRune: '‐'}, "12 345 678‐5"},
+		{"lowercase", RUT{Number: 1009, DV: 'K'}, FormatOptions{DashRune: '-', Lowercase: true}, "1009-k"},
+		{"uppercase", RUT{Number: 1009, DV: 'K'}, FormatOptions{DashRune: '-'}, "1009-K"},
+		{"padded", RUT{Number: 9123456, DV: '7'}, FormatOptions{Separator: '.', DashRune: '-', PadWidth: 8}, "09.123.456-7"},
+		{"padded narrow", RUT{Number: 12345678, DV: '5'}, FormatOptions{PadWidth: 4}, "123456785"},
+		{"padded wide", RUT{Number: 1009, DV: 'K'}, FormatOptions{PadWidth: 10, DashRune: '-'}, "0000001009-K"},
+		{"omit DV", RUT{Number: 12345678, DV: '5'}, FormatOptions{Separator: '.', DashRune: '-', OmitDV: true}, "12.345.678"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.r.FormatWith(tt.opts); got != tt.expected {
+				t.Errorf("FormatWith(%+v) = %q; want %q", tt.opts, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatWith(t *testing.T) {
+	got, err := FormatWith("1.009-k", FormatOptions{DashRune: '/', Lowercase: true})
+	if err != nil {
+		t.Fatalf("FormatWith() error = %v", err)
+	}
+	if got != "1009/k" {
+		t.Errorf("FormatWith() = %q; want %q", got, "1009/k")
+	}
+
+	if _, err := FormatWith("abc", FormatOptions{}); err == nil {
+		t.Error("FormatWith(\"abc\") error = nil; want error")
+	}
+}
+
+func TestFormatStyle_Options(t *testing.T) {
+	r := RUT{Number: 12345678, DV: '5'}
+	for _, style := range []FormatStyle{FormatComplete, FormatEscaped, FormatWithDash, FormatSpaces, FormatNumberOnly, FormatNumberDots} {
+		if got, want := r.FormatWith(style.Options()), r.Format(style); got != want {
+			t.Errorf("FormatWith(%v.Options()) = %q; want %q", style, got, want)
+		}
+	}
+}
+
+func TestFormatWith_LowercaseRoundTrip(t *testing.T) {
+	r := RUT{Number: 1009, DV: 'K'}
+	for _, style := range []FormatStyle{FormatComplete, FormatEscaped, FormatWithDash, FormatSpaces} {
+		opts := style.Options()
+		opts.Lowercase = true
+
+		s := r.FormatWith(opts)
+		if s[len(s)-1] != 'k' {
+			t.Errorf("FormatWith(%+v) = %q; want lowercase check digit", opts, s)
+		}
+		got, err := Parse(s)
+		if err != nil {
+			t.Fatalf("Parse(%q) error = %v", s, err)
+		}
+		if got != r {
+			t.Errorf("Parse(%q) = %v; want %v", s, got, r)
+		}
+	}
+}
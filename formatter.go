@@ -0,0 +1,60 @@
+package rut
+
+import "fmt"
+
+// Formatter adapts a RUT to fmt.Formatter. RUT cannot implement the
+// interface itself because its Format method takes a FormatStyle.
+//
+// The supported verbs are:
+//
+//	%s, %v  12.345.678-5 (FormatComplete)
+//	%-s     12345678-5   (FormatWithDash)
+//	%#s     123456785    (FormatEscaped)
+//	%q      "12.345.678-5", accepting the same flags as %s
+//	%d      12345678, the number alone, accepting the usual integer flags
+//
+// A width pads the %s, %v and %q output with spaces on the left.
+//
+//	fmt.Printf("%-s\n", rut.Formatter(r))
+type Formatter RUT
+
+// Format implements fmt.Formatter.
+func (f Formatter) Format(s fmt.State, verb rune) {
+	r := RUT(f)
+
+	switch verb {
+	case 'd':
+		fmt.Fprintf(s, fmt.FormatString(s, verb), r.Number)
+		return
+	case 's', 'v', 'q':
+	default:
+		fmt.Fprintf(s, "%%!%c(rut.Formatter=%s)", verb, r.String())
+		return
+	}
+
+	style := FormatComplete
+	switch {
+	case s.Flag('#'):
+		style = FormatEscaped
+	case s.Flag('-'):
+		style = FormatWithDash
+	}
+
+	// Max length is 27: "123.456.789.012.345.678-K" quoted
+	var buf [27]byte
+	b := buf[:0]
+	if verb == 'q' {
+		b = append(b, '"')
+	}
+	b = r.AppendFormat(b, style)
+	if verb == 'q' {
+		b = append(b, '"')
+	}
+
+	if w, ok := s.Width(); ok {
+		for i := len(b); i < w; i++ {
+			s.Write([]byte{' '})
+		}
+	}
+	s.Write(b)
+}
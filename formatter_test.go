@@ -0,0 +1,32 @@
+package rut
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestFormatter(t *testing.T) {
+	r := Formatter(RUT{Number: 12345678, DV: '5'})
+
+	tests := []struct {
+		format   string
+		expected string
+	}{
+		{"%s", "12.345.678-5"},
+		{"%v", "12.345.678-5"},
+		{"%-s", "12345678-5"},
+		{"%#s", "123456785"},
+		{"%q", `"12.345.678-5"`},
+		{"%-q", `"12345678-5"`},
+		{"%d", "12345678"},
+		{"%010d", "0012345678"},
+		{"%14s", "  12.345.678-5"},
+		{"%x", "%!x(rut.Formatter=12.345.678-5)"},
+	}
+
+	for _, tt := range tests {
+		if got := fmt.Sprintf(tt.format, r); got != tt.expected {
+			t.Errorf("Sprintf(%q) = %q; want %q", tt.format, got, tt.expected)
+		}
+	}
+}
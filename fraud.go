@@ -0,0 +1,112 @@
+package rut
+
+import "strconv"
+
+// Signal is a fraud heuristic that Signals found in a RUT.
+type Signal string
+
+// Signals reported by Signals, see Signal.Weight for their contribution to
+// Score.
+const (
+	SignalInvalidDV      Signal = "invalid_dv"      // The check digit does not match
+	SignalKnownTest      Signal = "known_test"      // A RUT widely used in examples and tests
+	SignalRepeatedDigits Signal = "repeated_digits" // 11.111.111, 7.777.777, ...
+	SignalSequence       Signal = "sequence"        // 12.345.678, 98.765.432, ...
+	SignalLowNumber      Signal = "low_number"      // A number below 1.000
+	SignalPeriodic       Signal = "periodic"        // A repeated group, as in 12.121.212 or 12.312.312
+	SignalReserved       Signal = "reserved"        // A placeholder RUT, see RUT.IsReserved
+	SignalRoundNumber    Signal = "round_number"    // Five or more trailing zeros, as in 10.000.000
+)
+
+var signalWeights = map[Signal]float64{
+	SignalInvalidDV:      1,
+	SignalKnownTest:      0.9,
+	SignalRepeatedDigits: 0.9,
+	SignalSequence:       0.8,
+	SignalLowNumber:      0.7,
+	SignalPeriodic:       0.6,
+	SignalReserved:       0.5,
+	SignalRoundNumber:    0.4,
+}
+
+// Weight returns the probability, from 0 to 1, that a RUT showing only
+// this signal was made up. Unknown signals weigh 0.
+func (s Signal) Weight() float64 {
+	return signalWeights[s]
+}
+
+// knownTest are RUTs found in documentation, tutorials and test suites
+// that people type to get past a form.
+var knownTest = []RUT{
+	{Number: 1, DV: '9'},
+	{Number: 11_111_111, DV: '1'},
+	{Number: 12_345_678, DV: '5'},
+	{Number: 60_803_000, DV: 'K'}, // The SII, receptor of DTE certification sets
+}
+
+// Signals returns the fraud heuristics found in r, ordered by weight.
+func Signals(r RUT) []Signal {
+	var sigs []Signal
+	if !r.Validate() {
+		sigs = append(sigs, SignalInvalidDV)
+	}
+	for _, v := range knownTest {
+		if r.Number == v.Number {
+			sigs = append(sigs, SignalKnownTest)
+			break
+		}
+	}
+	repeated, sequence := digitPatterns(r.Number)
+	if repeated {
+		sigs = append(sigs, SignalRepeatedDigits)
+	}
+	if sequence {
+		sigs = append(sigs, SignalSequence)
+	}
+	if r.Number < 1000 {
+		sigs = append(sigs, SignalLowNumber)
+	}
+	if !repeated && isPeriodic(r.Number) {
+		sigs = append(sigs, SignalPeriodic)
+	}
+	if r.IsReserved() {
+		sigs = append(sigs, SignalReserved)
+	}
+	if !repeated && r.Number >= 100_000 && r.Number%100_000 == 0 {
+		sigs = append(sigs, SignalRoundNumber)
+	}
+	return sigs
+}
+
+// Score returns a heuristic risk, from 0 to 1, that r was fabricated rather
+// than copied from a document. The weights of the signals found are
+// combined as independent probabilities, so more signals give a higher
+// score and a RUT without signals scores 0. Score only looks at the RUT
+// itself and is meant as one feature among many, not as a verdict.
+func Score(r RUT) float64 {
+	clean := 1.0
+	for _, s := range Signals(r) {
+		clean *= 1 - s.Weight()
+	}
+	return 1 - clean
+}
+
+// isPeriodic reports whether the digits of n, at least six, repeat a group
+// of two or three digits.
+func isPeriodic(n int) bool {
+	var buf [20]byte
+	digits := strconv.AppendInt(buf[:0], int64(n), 10)
+	if len(digits) < 6 {
+		return false
+	}
+	for period := 2; period <= 3; period++ {
+		match := true
+		for i := period; i < len(digits) && match; i++ {
+			match = digits[i] == digits[i-period]
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,49 @@
+package rut
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestSignals(t *testing.T) {
+	tests := []struct {
+		r    RUT
+		want []Signal
+	}{
+		{RUT{Number: 15_234_876, DV: '2'}, nil},
+		{RUT{Number: 15_234_876, DV: '3'}, []Signal{SignalInvalidDV}},
+		{RUT{Number: 12_345_678, DV: '5'}, []Signal{SignalKnownTest, SignalSequence}},
+		{RUT{Number: 11_111_111, DV: '1'}, []Signal{SignalKnownTest, SignalRepeatedDigits}},
+		{RUT{Number: 1, DV: '9'}, []Signal{SignalKnownTest, SignalRepeatedDigits, SignalLowNumber}},
+		{RUT{Number: 60_803_000, DV: 'K'}, []Signal{SignalKnownTest}},
+		{RUT{Number: 12_121_212, DV: '9'}, []Signal{SignalPeriodic}},
+		{RUT{Number: 12_312_312, DV: '3'}, []Signal{SignalPeriodic}},
+		{RUT{Number: 66_666_666, DV: '6'}, []Signal{SignalRepeatedDigits, SignalReserved}},
+		{RUT{Number: 10_000_000, DV: '8'}, []Signal{SignalRoundNumber}},
+	}
+
+	for _, tt := range tests {
+		if got := Signals(tt.r); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Signals(%v) = %v; want %v", tt.r, got, tt.want)
+		}
+	}
+}
+
+func TestScore(t *testing.T) {
+	tests := []struct {
+		r    RUT
+		want float64
+	}{
+		{RUT{Number: 15_234_876, DV: '2'}, 0},
+		{RUT{Number: 15_234_876, DV: '3'}, 1},
+		{RUT{Number: 10_000_000, DV: '8'}, 0.4},
+		{RUT{Number: 12_345_678, DV: '5'}, 0.98},
+	}
+
+	for _, tt := range tests {
+		if got := Score(tt.r); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("Score(%v) = %v; want %v", tt.r, got, tt.want)
+		}
+	}
+}
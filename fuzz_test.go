@@ -0,0 +1,112 @@
+package rut
+
+import (
+	"errors"
+	"testing"
+)
+
+// fuzzStyles are the styles whose output Parse reads back.
+var fuzzStyles = []FormatStyle{FormatComplete, FormatEscaped, FormatWithDash, FormatSpaces}
+
+// FuzzParse checks the invariants of Parse on arbitrary input:
+//
+//	go test -fuzz=FuzzParse
+func FuzzParse(f *testing.F) {
+	for _, s := range []string{
+		"12.345.678-5", "12345678-5", "123456785", "12 345 678-5",
+		"1.009-k", "1.009-K", "60.803.000-K", "1.000-6", "1000-6",
+		"999.999.999-K", "1.000.000.000-0", "0.000-0", "00012345678-5",
+		"12.345.678–5", "12.345.678—5", "12 345 678-5", "12.345.678−5",
+		"1234.5678-5", "12..345.678-5", "-123456785", "12.345.678-", "K",
+		"", " ", "1-9", "12-3", "1234-", "12.3a5.678-5", "12.345.K78-5",
+		"\xff\xfe", "RUT: 12.345.678-5",
+	} {
+		f.Add(s)
+	}
+
+	lenient := NewParser(WithLenientSeparators())
+	f.Fuzz(func(t *testing.T, s string) {
+		r, err := Parse(s)
+		if rb, errb := ParseBytes([]byte(s)); rb != r || (errb == nil) != (err == nil) {
+			t.Fatalf("ParseBytes(%q) = %v, %v; Parse = %v, %v", s, rb, errb, r, err)
+		}
+		if _, serr := ParseStrict(s); (serr == nil) != (err == nil && r.Validate()) {
+			t.Fatalf("ParseStrict(%q) = %v; Parse = %v, %v", s, serr, r, err)
+		}
+		if Validate(s) != (err == nil && r.Validate()) {
+			t.Fatalf("Validate(%q) = %v; Parse = %v, %v", s, Validate(s), r, err)
+		}
+
+		if err != nil {
+			var perr *ParseError
+			if !errors.As(err, &perr) {
+				t.Fatalf("Parse(%q) error = %T; want *ParseError", s, err)
+			}
+			if perr.Pos < -1 || perr.Pos >= len(s) {
+				t.Fatalf("Parse(%q) error position = %d; out of range", s, perr.Pos)
+			}
+			if CodeOf(err) == "" {
+				t.Fatalf("Parse(%q) error = %v; want a package error", s, err)
+			}
+			return
+		}
+
+		if r.Number < 0 || (r.DV != 'K' && r.DV != 'k' && (r.DV < '0' || r.DV > '9')) {
+			t.Fatalf("Parse(%q) = %+v; want a number and a check digit", s, r)
+		}
+		if lr, lerr := lenient.Parse(s); lerr != nil || lr != r {
+			t.Fatalf("lenient Parse(%q) = %v, %v; want %v, nil", s, lr, lerr, r)
+		}
+		if r.Number < 1000 {
+			return // Formatted without zero padding, it is too short to parse
+		}
+		for _, style := range fuzzStyles {
+			out := r.Format(style)
+			if got, err := Parse(out); err != nil || !got.Equal(r) {
+				t.Fatalf("Parse(%q) = %v, %v; want %v, nil (from %q)", out, got, err, r, s)
+			}
+		}
+	})
+}
+
+// FuzzFormatRoundTrip checks that every style formats a RUT in a form that
+// parses back to it and formats to the same string again:
+//
+//	go test -fuzz=FuzzFormatRoundTrip
+func FuzzFormatRoundTrip(f *testing.F) {
+	for _, n := range []uint32{1000, 1009, 9999, 10_000, 999_999, 1_000_000, 12_345_678, 60_803_000, 99_999_999, 100_000_000, 999_999_999} {
+		f.Add(n, false)
+		f.Add(n, true)
+	}
+
+	f.Fuzz(func(t *testing.T, n uint32, wrongDV bool) {
+		n %= 1_000_000_000
+		if n < 1000 {
+			n += 1000
+		}
+		r := RUT{Number: int(n), DV: CalculateDV(int(n))}
+		if wrongDV {
+			r.DV = CalculateDV(int(n) + 1)
+			if r.DV == CalculateDV(int(n)) {
+				return
+			}
+		}
+
+		for _, style := range fuzzStyles {
+			s := r.Format(style)
+			got, err := Parse(s)
+			if err != nil || got != r {
+				t.Fatalf("Parse(%q) = %v, %v; want %v, nil", s, got, err, r)
+			}
+			if got.Validate() == wrongDV {
+				t.Fatalf("Parse(%q).Validate() = %v; want %v", s, got.Validate(), !wrongDV)
+			}
+			if again, err := Format(s, style); err != nil || again != s {
+				t.Fatalf("Format(%q, %v) = %q, %v; want %q, nil", s, style, again, err, s)
+			}
+			if detected, ok := detectStyle(s); !ok || detected != style {
+				t.Fatalf("detectStyle(%q) = %v, %v; want %v, true", s, detected, ok, style)
+			}
+		}
+	})
+}
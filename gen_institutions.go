@@ -0,0 +1,67 @@
+//go:build ignore
+
+// This program generates institutions_gen.go from institutions.csv. Run it
+// with go generate.
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"slices"
+
+	"github.com/jestays/rut-go"
+)
+
+func main() {
+	f, err := os.Open("institutions.csv")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	cr := csv.NewReader(f)
+	cr.Comment = '#'
+	cr.FieldsPerRecord = 3
+	records, err := cr.ReadAll()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var list []rut.Institution
+	for _, rec := range records {
+		r, err := rut.ParseStrict(rec[0])
+		if err != nil {
+			log.Fatalf("%s: %v", rec[0], err)
+		}
+		list = append(list, rut.Institution{RUT: r, Name: rec[1], ShortName: rec[2]})
+	}
+	slices.SortFunc(list, func(a, b rut.Institution) int {
+		return rut.Compare(a.RUT, b.RUT)
+	})
+	for i := 1; i < len(list); i++ {
+		if list[i].RUT == list[i-1].RUT {
+			log.Fatalf("%v: duplicate RUT", list[i].RUT)
+		}
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen_institutions.go from institutions.csv; DO NOT EDIT.\n\n")
+	buf.WriteString("package rut\n\n")
+	buf.WriteString("var institutions = []Institution{\n")
+	for _, in := range list {
+		fmt.Fprintf(&buf, "\t{RUT{Number: %d, DV: '%c'}, %q, %q},\n", in.RUT.Number, in.RUT.DV, in.Name, in.ShortName)
+	}
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("institutions_gen.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
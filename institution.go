@@ -0,0 +1,46 @@
+package rut
+
+import "slices"
+
+//go:generate go run gen_institutions.go
+
+// Institution is a well-known public institution or company.
+type Institution struct {
+	RUT       RUT
+	Name      string // Official name, such as "Servicio de Impuestos Internos"
+	ShortName string // Acronym or common name, such as "SII"
+}
+
+// Institutions returns the registry of well-known institutional RUTs,
+// sorted by RUT. The registry is generated from institutions.csv.
+func Institutions() []Institution {
+	return slices.Clone(institutions)
+}
+
+// LookupInstitution returns the institution with RUT r, if it is in the
+// registry.
+func LookupInstitution(r RUT) (Institution, bool) {
+	i, ok := slices.BinarySearchFunc(institutions, r.Number, func(in Institution, n int) int {
+		return in.RUT.Number - n
+	})
+	if !ok {
+		return Institution{}, false
+	}
+	return institutions[i], true
+}
+
+// InstitutionByName returns the institution whose name or short name
+// matches name, ignoring case, accents, spaces and punctuation, so
+// "tesoreria general de la republica" and "TGR" both match.
+func InstitutionByName(name string) (Institution, bool) {
+	key := normalizeLabel(name)
+	if key == "" {
+		return Institution{}, false
+	}
+	for _, in := range institutions {
+		if normalizeLabel(in.Name) == key || normalizeLabel(in.ShortName) == key {
+			return in, true
+		}
+	}
+	return Institution{}, false
+}
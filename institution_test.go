@@ -0,0 +1,67 @@
+package rut
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestInstitutions(t *testing.T) {
+	list := Institutions()
+	if len(list) == 0 {
+		t.Fatal("Institutions() is empty")
+	}
+	for _, in := range list {
+		if !in.RUT.Validate() || in.Name == "" || in.ShortName == "" {
+			t.Errorf("invalid registry entry %+v", in)
+		}
+	}
+	if !slices.IsSortedFunc(list, func(a, b Institution) int { return Compare(a.RUT, b.RUT) }) {
+		t.Errorf("Institutions() is not sorted by RUT")
+	}
+
+	// The registry cannot be modified through the returned slice
+	list[0].Name = "changed"
+	if Institutions()[0].Name == "changed" {
+		t.Errorf("Institutions() returned the registry itself")
+	}
+}
+
+func TestLookupInstitution(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+		found bool
+	}{
+		{"60.803.000-K", "SII", true},
+		{"97030000-7", "BANCOESTADO", true},
+		{"12.345.678-5", "", false},
+	}
+
+	for _, tt := range tests {
+		in, ok := LookupInstitution(MustParse(tt.input))
+		if ok != tt.found || in.ShortName != tt.want {
+			t.Errorf("LookupInstitution(%q) = %q, %v; want %q, %v", tt.input, in.ShortName, ok, tt.want, tt.found)
+		}
+	}
+}
+
+func TestInstitutionByName(t *testing.T) {
+	tests := []struct {
+		name  string
+		want  RUT
+		found bool
+	}{
+		{"tesoreria general de la republica", MustParse("60.805.000-0"), true},
+		{"SII", MustParse("60.803.000-K"), true},
+		{"Fonasa", MustParse("61.603.000-0"), true},
+		{"Servicio", RUT{}, false},
+		{"", RUT{}, false},
+	}
+
+	for _, tt := range tests {
+		in, ok := InstitutionByName(tt.name)
+		if ok != tt.found || in.RUT != tt.want {
+			t.Errorf("InstitutionByName(%q) = %v, %v; want %v, %v", tt.name, in.RUT, ok, tt.want, tt.found)
+		}
+	}
+}
@@ -0,0 +1,15 @@
+// Code generated by gen_institutions.go from institutions.csv; DO NOT EDIT.
+
+package rut
+
+var institutions = []Institution{
+	{RUT{Number: 60803000, DV: 'K'}, "Servicio de Impuestos Internos", "SII"},
+	{RUT{Number: 60805000, DV: '0'}, "Tesorería General de la República", "TGR"},
+	{RUT{Number: 60910000, DV: '1'}, "Universidad de Chile", "UCHILE"},
+	{RUT{Number: 61002000, DV: '3'}, "Servicio de Registro Civil e Identificación", "SRCEI"},
+	{RUT{Number: 61502000, DV: '1'}, "Dirección del Trabajo", "DT"},
+	{RUT{Number: 61603000, DV: '0'}, "Fondo Nacional de Salud", "FONASA"},
+	{RUT{Number: 61704000, DV: 'K'}, "Corporación Nacional del Cobre de Chile", "CODELCO"},
+	{RUT{Number: 97029000, DV: '1'}, "Banco Central de Chile", "BCCH"},
+	{RUT{Number: 97030000, DV: '7'}, "Banco del Estado de Chile", "BANCOESTADO"},
+}
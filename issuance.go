@@ -0,0 +1,66 @@
+package rut
+
+// issuanceBands are rough year ranges in which the Registro Civil assigned
+// RUNs below each number. They were compiled from published samples, not
+// from an official source, and overlap on purpose.
+var issuanceBands = []struct {
+	below    int
+	from, to int
+}{
+	{3_000_000, 1900, 1935},
+	{5_000_000, 1925, 1950},
+	{7_000_000, 1940, 1960},
+	{9_000_000, 1950, 1968},
+	{11_000_000, 1960, 1975},
+	{13_000_000, 1968, 1980},
+	{15_000_000, 1975, 1985},
+	{17_000_000, 1982, 1992},
+	{19_000_000, 1988, 1998},
+	{20_000_000, 1995, 2001},
+	{21_000_000, 1999, 2004},
+	{22_000_000, 2002, 2008},
+	{23_000_000, 2006, 2011},
+	{24_000_000, 2009, 2014},
+	{25_000_000, 2012, 2018},
+	{27_000_000, 2015, 2021},
+	{30_000_000, 2019, 2026},
+}
+
+// EstimateIssuanceYear returns the approximate range of years in which the
+// RUN r was assigned, or ok false if r is not a natural person RUN or is
+// above the known bands. Since the 1970s RUNs are mostly assigned at birth,
+// so the range hints at the age of the holder.
+//
+// The estimate is a heuristic for soft sanity checks, such as asking for a
+// second look when a RUN suggests a minor: people registered late and
+// foreigners receiving a RUN as adults have numbers far newer than their
+// birth, and the bands are approximate. Never reject a RUT based on it.
+func EstimateIssuanceYear(r RUT) (from, to int, ok bool) {
+	if !r.IsPerson() || r.IsForeignInvestor() {
+		return 0, 0, false
+	}
+	for _, b := range issuanceBands {
+		if r.Number < b.below {
+			return b.from, b.to, true
+		}
+	}
+	return 0, 0, false
+}
+
+// IssuanceNumbers returns the range of RUN numbers, from lo to hi
+// inclusive, for which EstimateIssuanceYear includes year, or ok false if
+// no band covers year. It is meant for generating test data coherent with
+// a birth year, with the same caveats as EstimateIssuanceYear.
+func IssuanceNumbers(year int) (lo, hi int, ok bool) {
+	below := MinPersonNumber
+	for _, b := range issuanceBands {
+		if year >= b.from && year <= b.to {
+			if !ok {
+				lo, ok = below, true
+			}
+			hi = b.below - 1
+		}
+		below = b.below
+	}
+	return lo, hi, ok
+}
@@ -0,0 +1,77 @@
+package rut
+
+import "testing"
+
+func TestEstimateIssuanceYear(t *testing.T) {
+	tests := []struct {
+		r        RUT
+		from, to int
+		ok       bool
+	}{
+		{RUT{Number: 1_234_567, DV: '4'}, 1900, 1935, true},
+		{RUT{Number: 12_345_678, DV: '5'}, 1968, 1980, true},
+		{RUT{Number: 19_999_999, DV: '3'}, 1995, 2001, true},
+		{RUT{Number: 20_000_000, DV: '0'}, 1999, 2004, true},
+		{RUT{Number: 26_500_000, DV: '0'}, 2015, 2021, true},
+		{RUT{Number: 31_000_000, DV: '0'}, 0, 0, false},
+		{RUT{Number: 46_500_000, DV: '0'}, 0, 0, false},
+		{RUT{Number: 60_803_000, DV: 'K'}, 0, 0, false},
+		{RUT{Number: 100_123_456, DV: '0'}, 0, 0, false},
+		{RUT{}, 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		from, to, ok := EstimateIssuanceYear(tt.r)
+		if from != tt.from || to != tt.to || ok != tt.ok {
+			t.Errorf("EstimateIssuanceYear(%v) = %d, %d, %v; want %d, %d, %v",
+				tt.r, from, to, ok, tt.from, tt.to, tt.ok)
+		}
+	}
+}
+
+func TestIssuanceBands(t *testing.T) {
+	for i, b := range issuanceBands {
+		if b.from > b.to {
+			t.Errorf("issuanceBands[%d] = %v; from after to", i, b)
+		}
+		if i > 0 && b.below <= issuanceBands[i-1].below {
+			t.Errorf("issuanceBands[%d] = %v; not sorted", i, b)
+		}
+	}
+}
+
+func TestIssuanceNumbers(t *testing.T) {
+	tests := []struct {
+		year   int
+		lo, hi int
+		ok     bool
+	}{
+		{1900, 1, 2_999_999, true},
+		{1930, 1, 4_999_999, true},
+		{1970, 9_000_000, 12_999_999, true},
+		{2000, 19_000_000, 20_999_999, true},
+		{2026, 27_000_000, 29_999_999, true},
+		{1899, 0, 0, false},
+		{2027, 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		lo, hi, ok := IssuanceNumbers(tt.year)
+		if lo != tt.lo || hi != tt.hi || ok != tt.ok {
+			t.Errorf("IssuanceNumbers(%d) = %d, %d, %v; want %d, %d, %v",
+				tt.year, lo, hi, ok, tt.lo, tt.hi, tt.ok)
+		}
+	}
+}
+
+func TestIssuanceNumbers_Inverse(t *testing.T) {
+	for year := 1900; year <= 2026; year++ {
+		lo, hi, _ := IssuanceNumbers(year)
+		for _, n := range []int{lo, hi} {
+			from, to, ok := EstimateIssuanceYear(RUT{Number: n, DV: CalculateDV(n)})
+			if !ok || year < from || year > to {
+				t.Errorf("EstimateIssuanceYear(%d) = %d, %d, %v; want a range including %d", n, from, to, ok, year)
+			}
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package rut
+
+import "encoding/json"
+
+// defaultSchemaDescription is the description of the JSON Schema fragment
+// returned by JSONSchema.
+const defaultSchemaDescription = "Chilean RUT, with or without dots and dash, " +
+	"such as 12.345.678-5, 12345678-5 or 123456785. The check digit is " +
+	"validated by the server."
+
+// JSONSchemaOptions customizes the fragment returned by JSONSchema.
+type JSONSchemaOptions struct {
+	Format      string // Value of the "format" keyword, such as "rut"; empty omits it
+	Description string // Replaces the default description
+}
+
+// JSONSchema returns a JSON Schema fragment describing a RUT string, whose
+// pattern is Pattern, for teams generating schemas from Go types:
+//
+//	{"type":"string","pattern":"^[.\\- ]*...$","description":"Chilean RUT, ...","examples":["12.345.678-5"]}
+//
+// A pattern cannot check the check digit, so pair it with a custom format
+// validated by the server, such as the ones in the rutstrfmt and
+// rutopenapi3 modules.
+func JSONSchema(opts JSONSchemaOptions) json.RawMessage {
+	if opts.Description == "" {
+		opts.Description = defaultSchemaDescription
+	}
+	schema := struct {
+		Type        string   `json:"type"`
+		Format      string   `json:"format,omitempty"`
+		Pattern     string   `json:"pattern"`
+		Description string   `json:"description"`
+		Examples    []string `json:"examples"`
+	}{
+		Type:        "string",
+		Format:      opts.Format,
+		Pattern:     Pattern(),
+		Description: opts.Description,
+		Examples:    []string{"12.345.678-5"},
+	}
+	data, _ := json.Marshal(schema)
+	return data
+}
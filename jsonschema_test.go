@@ -0,0 +1,39 @@
+package rut
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+)
+
+func TestJSONSchema(t *testing.T) {
+	var schema map[string]any
+	if err := json.Unmarshal(JSONSchema(JSONSchemaOptions{}), &schema); err != nil {
+		t.Fatalf("JSONSchema() is not valid JSON: %v", err)
+	}
+	if schema["type"] != "string" || schema["pattern"] != Pattern() || schema["description"] != defaultSchemaDescription {
+		t.Errorf("JSONSchema() = %v", schema)
+	}
+	if _, ok := schema["format"]; ok {
+		t.Errorf("JSONSchema() has a format without JSONSchemaOptions.Format")
+	}
+
+	// Examples must match the pattern
+	re := regexp.MustCompile(schema["pattern"].(string))
+	for _, example := range schema["examples"].([]any) {
+		if !re.MatchString(example.(string)) || !Validate(example.(string)) {
+			t.Errorf("example %q is not a valid RUT", example)
+		}
+	}
+}
+
+func TestJSONSchema_Options(t *testing.T) {
+	var schema map[string]any
+	data := JSONSchema(JSONSchemaOptions{Format: "rut", Description: "Issuer"})
+	if err := json.Unmarshal(data, &schema); err != nil {
+		t.Fatal(err)
+	}
+	if schema["format"] != "rut" || schema["description"] != "Issuer" {
+		t.Errorf("JSONSchema() = %s", data)
+	}
+}
@@ -0,0 +1,113 @@
+package rut
+
+import (
+	"errors"
+	"strconv"
+)
+
+// Classification errors
+var (
+	ErrNotPerson  = errors.New("rut: not a natural person RUT")
+	ErrNotCompany = errors.New("rut: not a company RUT")
+)
+
+// Numeric ranges used to tell natural persons from companies.
+// By convention, numbers below 50.000.000 are assigned to natural persons
+// (RUN) and numbers from 50.000.000 up to 99.999.999 to legal entities.
+const (
+	MinPersonNumber  = 1
+	MaxPersonNumber  = 49_999_999
+	MinCompanyNumber = 50_000_000
+	MaxCompanyNumber = 99_999_999
+
+	// Provisional identifiers given to foreigners without a definitive
+	// RUN, such as the IPE used by schools, start at 100.000.000.
+	MinProvisionalNumber = 100_000_000
+	MaxProvisionalNumber = 999_999_999
+
+	// The SII has historically assigned numbers from 46.000.000 to
+	// 47.999.999, inside the natural person range, to foreign investors.
+	MinForeignInvestorNumber = 46_000_000
+	MaxForeignInvestorNumber = 47_999_999
+)
+
+// Kind classifies a RUT by the range its number falls in.
+type Kind int
+
+const (
+	KindUnknown     Kind = iota // Number out of every range, such as the zero RUT
+	KindPerson                  // Natural person RUN
+	KindCompany                 // Legal entity
+	KindProvisional             // Provisional RUN of a foreigner
+)
+
+var kindNames = [...]string{"unknown", "person", "company", "provisional"}
+
+func (k Kind) String() string {
+	if k < 0 || int(k) >= len(kindNames) {
+		return "Kind(" + strconv.Itoa(int(k)) + ")"
+	}
+	return kindNames[k]
+}
+
+// Kind returns the kind of the RUT according to its number, so callers can
+// branch on it:
+//
+//	switch r.Kind() {
+//	case rut.KindProvisional:
+//		// Ask for the definitive RUN when available
+//	}
+func (r RUT) Kind() Kind {
+	switch {
+	case r.IsPerson():
+		return KindPerson
+	case r.IsCompany():
+		return KindCompany
+	case r.IsProvisional():
+		return KindProvisional
+	}
+	return KindUnknown
+}
+
+// NewPersonRUT builds the RUT of a natural person from its number,
+// computing the check digit. It returns ErrNotPerson if the number is
+// outside the range assigned to natural persons.
+func NewPersonRUT(number int) (RUT, error) {
+	if number < MinPersonNumber || number > MaxPersonNumber {
+		return RUT{}, ErrNotPerson
+	}
+	return RUT{Number: number, DV: CalculateDV(number)}, nil
+}
+
+// NewCompanyRUT builds the RUT of a company from its number, computing
+// the check digit. It returns ErrNotCompany if the number is outside the
+// range assigned to legal entities.
+func NewCompanyRUT(number int) (RUT, error) {
+	if number < MinCompanyNumber || number > MaxCompanyNumber {
+		return RUT{}, ErrNotCompany
+	}
+	return RUT{Number: number, DV: CalculateDV(number)}, nil
+}
+
+// IsPerson reports whether the RUT number falls in the natural person range.
+func (r RUT) IsPerson() bool {
+	return r.Number >= MinPersonNumber && r.Number <= MaxPersonNumber
+}
+
+// IsCompany reports whether the RUT number falls in the company range.
+func (r RUT) IsCompany() bool {
+	return r.Number >= MinCompanyNumber && r.Number <= MaxCompanyNumber
+}
+
+// IsForeignInvestor reports whether the RUT number falls in the range
+// historically assigned to foreign investors. Such RUTs are also reported
+// by IsPerson, and Kind returns KindPerson for them.
+func (r RUT) IsForeignInvestor() bool {
+	return r.Number >= MinForeignInvestorNumber && r.Number <= MaxForeignInvestorNumber
+}
+
+// IsProvisional reports whether the RUT number falls in the range of the
+// provisional RUNs assigned to foreigners.
+func (r RUT) IsProvisional() bool {
+	return r.Number >= MinProvisionalNumber && r.Number <= MaxProvisionalNumber
+}
@@ -0,0 +1,122 @@
+package rut
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewPersonRUT(t *testing.T) {
+	tests := []struct {
+		num     int
+		want    string
+		wantErr error
+	}{
+		{12345678, "12.345.678-5", nil},
+		{1009, "1.009-K", nil},
+		{49999999, "49.999.999-2", nil},
+		{50000000, "", ErrNotPerson},
+		{76123456, "", ErrNotPerson},
+		{0, "", ErrNotPerson},
+		{-1, "", ErrNotPerson},
+	}
+
+	for _, tt := range tests {
+		got, err := NewPersonRUT(tt.num)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("NewPersonRUT(%d) error = %v; want %v", tt.num, err, tt.wantErr)
+			continue
+		}
+		if err == nil && got.String() != tt.want {
+			t.Errorf("NewPersonRUT(%d) = %q; want %q", tt.num, got.String(), tt.want)
+		}
+	}
+}
+
+func TestNewCompanyRUT(t *testing.T) {
+	tests := []struct {
+		num     int
+		want    string
+		wantErr error
+	}{
+		{60803000, "60.803.000-K", nil},
+		{50000000, "50.000.000-7", nil},
+		{97030000, "97.030.000-7", nil},
+		{12345678, "", ErrNotCompany},
+		{100000000, "", ErrNotCompany},
+	}
+
+	for _, tt := range tests {
+		got, err := NewCompanyRUT(tt.num)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("NewCompanyRUT(%d) error = %v; want %v", tt.num, err, tt.wantErr)
+			continue
+		}
+		if err == nil && got.String() != tt.want {
+			t.Errorf("NewCompanyRUT(%d) = %q; want %q", tt.num, got.String(), tt.want)
+		}
+	}
+}
+
+func TestRUT_IsPersonIsCompany(t *testing.T) {
+	tests := []struct {
+		num         int
+		wantPerson  bool
+		wantCompany bool
+	}{
+		{12345678, true, false},
+		{60803000, false, true},
+		{0, false, false},
+		{123456789, false, false},
+	}
+
+	for _, tt := range tests {
+		r := RUT{Number: tt.num, DV: CalculateDV(tt.num)}
+		if got := r.IsPerson(); got != tt.wantPerson {
+			t.Errorf("RUT{%d}.IsPerson() = %v; want %v", tt.num, got, tt.wantPerson)
+		}
+		if got := r.IsCompany(); got != tt.wantCompany {
+			t.Errorf("RUT{%d}.IsCompany() = %v; want %v", tt.num, got, tt.wantCompany)
+		}
+	}
+}
+
+func TestRUT_Kind(t *testing.T) {
+	tests := []struct {
+		r    RUT
+		want Kind
+	}{
+		{MustParse("12.345.678-5"), KindPerson},
+		{MustParse("60.803.000-K"), KindCompany},
+		{MustParse("100.123.456-0"), KindProvisional},
+		{RUT{}, KindUnknown},
+	}
+
+	for _, tt := range tests {
+		if got := tt.r.Kind(); got != tt.want {
+			t.Errorf("%v.Kind() = %v; want %v", tt.r, got, tt.want)
+		}
+	}
+	if got := Kind(9).String(); got != "Kind(9)" {
+		t.Errorf("Kind(9).String() = %q; want %q", got, "Kind(9)")
+	}
+}
+
+func TestRUT_IsForeignInvestor(t *testing.T) {
+	tests := []struct {
+		number int
+		want   bool
+	}{
+		{45_999_999, false},
+		{46_000_000, true},
+		{47_123_456, true},
+		{47_999_999, true},
+		{48_000_000, false},
+	}
+
+	for _, tt := range tests {
+		r := RUT{Number: tt.number, DV: CalculateDV(tt.number)}
+		if got := r.IsForeignInvestor(); got != tt.want {
+			t.Errorf("%v.IsForeignInvestor() = %v; want %v", r, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package rut
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// normalizeSeparators maps the dash and space variants found in text copied
+// from PDFs and word processors to their ASCII counterparts, drops
+// invisible characters and trims surrounding whitespace.
+func normalizeSeparators(s string) string {
+	ascii := true
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			ascii = false
+			break
+		}
+	}
+	if ascii {
+		return strings.TrimSpace(s)
+	}
+
+	s = strings.Map(func(r rune) rune {
+		switch r {
+		case '\u2010', '\u2011', '\u2012', '\u2013', '\u2014', '\u2015',
+			'\u2212', '\ufe58', '\ufe63', '\uff0d':
+			// Hyphens, dashes and minus signs
+			return '-'
+		case '\u00ad', '\u200b', '\u200c', '\u200d', '\u2060', '\ufeff':
+			// Soft hyphen, zero width characters and byte order mark
+			return -1
+		}
+		if unicode.IsSpace(r) {
+			return ' '
+		}
+		return r
+	}, s)
+	return strings.TrimSpace(s)
+}
+
+// labels are the normalized forms of the captions that commonly precede a
+// RUT, see normalizeLabel.
+var labels = []string{
+	"rut",
+	"run",
+	"rolunicotributario",
+	"roluniconacional",
+	"ci",
+	"cedula",
+	"ceduladeidentidad",
+	"cedulanacionaldeidentidad",
+}
+
+// labelNumberSuffixes are the normalized forms of "Nº", "No.", "Nro.", ...
+var labelNumberSuffixes = []string{"", "n", "no", "nro", "num", "numero"}
+
+// stripLabels removes a caption such as "RUT:", "R.U.T." or
+// "Rol Único Tributario Nº" before the value, and trailing punctuation
+// after it. The string is returned unchanged if the text before the first
+// digit is not a known caption.
+func stripLabels(s string) string {
+	s = strings.TrimSpace(s)
+
+	// Trailing text and punctuation, e.g. in "(RUT 12.345.678-5), Santiago"
+	if i := strings.IndexAny(s, ",;"); i >= 0 {
+		s = s[:i]
+	}
+	s = strings.TrimRight(s, " :)]")
+
+	start := strings.IndexFunc(s, func(r rune) bool {
+		return r >= '0' && r <= '9'
+	})
+	if start <= 0 {
+		return s
+	}
+	if isLabel(normalizeLabel(s[:start])) {
+		return s[start:]
+	}
+	return s
+}
+
+// normalizeLabel lowercases a caption and drops accents, punctuation and
+// spaces, so "R.U.T. Nº:" becomes "rutn".
+func normalizeLabel(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case 'á', 'Á':
+			return 'a'
+		case 'é', 'É':
+			return 'e'
+		case 'í', 'Í':
+			return 'i'
+		case 'ó', 'Ó':
+			return 'o'
+		case 'ú', 'Ú', 'ü', 'Ü':
+			return 'u'
+		case 'ñ', 'Ñ':
+			return 'n'
+		case 'º', 'ª':
+			// Ordinal indicators are letters, as in "Nº"
+			return -1
+		}
+		if unicode.IsLetter(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, s)
+}
+
+func isLabel(s string) bool {
+	for _, label := range labels {
+		rest, ok := strings.CutPrefix(s, label)
+		if !ok {
+			continue
+		}
+		for _, suffix := range labelNumberSuffixes {
+			if rest == suffix {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,240 @@
+package rut
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ListResult is the outcome of ListChecker.Check.
+type ListResult struct {
+	Allowed bool
+	Reason  string // Why the RUT was denied, empty if allowed
+}
+
+// ListChecker screens RUTs against a deny list and an optional allow list,
+// for fraud and sanctions checks. Lists are loaded from readers or files,
+// and files can be watched and reloaded while Check is in use. It is safe
+// for concurrent use.
+//
+// A list has one RUT per line, in any format accepted by Parse, optionally
+// followed by a comma and a reason reported by Check. Blank lines and lines
+// starting with # are ignored:
+//
+//	# OFAC 2024-05
+//	12.345.678-5, sanctioned entity
+//	7654321-6
+type ListChecker struct {
+	deny  atomic.Pointer[map[int]string]
+	allow atomic.Pointer[SparseSet]
+
+	mu       sync.Mutex
+	files    []*watchedList
+	onReload func(context.Context, ListReload)
+}
+
+// ListReload describes a list file reloaded by Watch, for logging and
+// tracing.
+type ListReload struct {
+	Path     string
+	Deny     bool          // Whether the file holds the deny list
+	Entries  int           // Entries in the new list, 0 if Err is set
+	Start    time.Time     // When the reload started
+	Duration time.Duration // How long reading and parsing the file took
+	Err      error         // Why the file was not loaded, if it was not
+}
+
+type watchedList struct {
+	path    string
+	deny    bool
+	modTime time.Time
+	size    int64
+}
+
+// NewListChecker returns a ListChecker with empty lists, allowing every
+// RUT.
+func NewListChecker() *ListChecker {
+	return &ListChecker{}
+}
+
+// Check reports whether r is allowed. A RUT in the deny list is denied with
+// the reason given in the list. When an allow list is loaded, RUTs not in it
+// are denied too.
+func (c *ListChecker) Check(r RUT) ListResult {
+	if deny := c.deny.Load(); deny != nil {
+		if reason, ok := (*deny)[r.Number]; ok {
+			if reason == "" {
+				reason = "in deny list"
+			}
+			return ListResult{Reason: reason}
+		}
+	}
+	if allow := c.allow.Load(); allow != nil && !allow.Contains(r) {
+		return ListResult{Reason: "not in allow list"}
+	}
+	return ListResult{Allowed: true}
+}
+
+// SetDenyList replaces the deny list with the one read from r. On error the
+// previous list is kept.
+func (c *ListChecker) SetDenyList(r io.Reader) error {
+	deny := make(map[int]string)
+	err := readList(r, func(v RUT, reason string) {
+		deny[v.Number] = reason
+	})
+	if err != nil {
+		return err
+	}
+	c.deny.Store(&deny)
+	return nil
+}
+
+// SetAllowList replaces the allow list with the one read from r. On error
+// the previous list is kept.
+func (c *ListChecker) SetAllowList(r io.Reader) error {
+	allow := NewSparseSet()
+	err := readList(r, func(v RUT, _ string) {
+		allow.Add(v)
+	})
+	if err != nil {
+		return err
+	}
+	c.allow.Store(allow)
+	return nil
+}
+
+// LoadDenyFile loads the deny list from the file at path, which Watch then
+// reloads when it changes.
+func (c *ListChecker) LoadDenyFile(path string) error {
+	return c.loadFile(&watchedList{path: path, deny: true})
+}
+
+// LoadAllowFile loads the allow list from the file at path, which Watch
+// then reloads when it changes.
+func (c *ListChecker) LoadAllowFile(path string) error {
+	return c.loadFile(&watchedList{path: path})
+}
+
+func (c *ListChecker) loadFile(w *watchedList) error {
+	if err := c.reload(w); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.files = slices.DeleteFunc(c.files, func(o *watchedList) bool { return o.deny == w.deny })
+	c.files = append(c.files, w)
+	return nil
+}
+
+// reload reads the file of w into its list and records its state.
+func (c *ListChecker) reload(w *watchedList) error {
+	f, err := os.Open(w.path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	// A broken file is reported once, not on every check
+	w.modTime, w.size = info.ModTime(), info.Size()
+	if w.deny {
+		err = c.SetDenyList(f)
+	} else {
+		err = c.SetAllowList(f)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", w.path, err)
+	}
+	return nil
+}
+
+// OnReload sets a function that Watch calls after every reload of a
+// file, failed or not, with the context passed to Watch. The rutotel
+// module uses it to trace reloads. Only one function is kept; nil
+// removes it.
+func (c *ListChecker) OnReload(fn func(context.Context, ListReload)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.onReload = fn
+}
+
+// entries returns the number of entries in the deny or allow list.
+func (c *ListChecker) entries(deny bool) int {
+	if deny {
+		if m := c.deny.Load(); m != nil {
+			return len(*m)
+		}
+		return 0
+	}
+	if s := c.allow.Load(); s != nil {
+		return s.Len()
+	}
+	return 0
+}
+
+// Watch checks the files loaded with LoadDenyFile and LoadAllowFile every
+// interval and reloads those that changed, until ctx is done. Reload errors,
+// such as an invalid line, are passed to onError, if not nil, and the
+// previous list is kept until the file is fixed.
+func (c *ListChecker) Watch(ctx context.Context, interval time.Duration, onError func(error)) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+
+		c.mu.Lock()
+		for _, w := range c.files {
+			info, err := os.Stat(w.path)
+			if err == nil && info.ModTime().Equal(w.modTime) && info.Size() == w.size {
+				continue
+			}
+			start := time.Now()
+			if err == nil {
+				err = c.reload(w)
+			}
+			if err != nil && onError != nil {
+				onError(err)
+			}
+			if c.onReload != nil {
+				ev := ListReload{Path: w.path, Deny: w.deny, Start: start, Duration: time.Since(start), Err: err}
+				if err == nil {
+					ev.Entries = c.entries(w.deny)
+				}
+				c.onReload(ctx, ev)
+			}
+		}
+		c.mu.Unlock()
+	}
+}
+
+// readList calls fn for every entry of a list in the ListChecker format.
+func readList(r io.Reader, fn func(RUT, string)) error {
+	sc := bufio.NewScanner(r)
+	for line := 1; sc.Scan(); line++ {
+		text := strings.TrimSpace(sc.Text())
+		if text == "" || text[0] == '#' {
+			continue
+		}
+		value, reason, _ := strings.Cut(text, ",")
+		v, err := ParseStrict(value)
+		if err != nil {
+			return fmt.Errorf("line %d: %w", line, err)
+		}
+		fn(v, strings.TrimSpace(reason))
+	}
+	return sc.Err()
+}
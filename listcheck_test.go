@@ -0,0 +1,151 @@
+package rut
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestListChecker(t *testing.T) {
+	c := NewListChecker()
+	if got := c.Check(MustParse("12.345.678-5")); !got.Allowed {
+		t.Errorf("Check() with empty lists = %+v; want allowed", got)
+	}
+
+	deny := "# sanctions\n12.345.678-5, sanctioned entity\n\n7654321-6\n"
+	if err := c.SetDenyList(strings.NewReader(deny)); err != nil {
+		t.Fatalf("SetDenyList() error = %v", err)
+	}
+	if err := c.SetAllowList(strings.NewReader("12345678-5\n60803000-K\n")); err != nil {
+		t.Fatalf("SetAllowList() error = %v", err)
+	}
+
+	tests := []struct {
+		input string
+		want  ListResult
+	}{
+		{"12.345.678-5", ListResult{Reason: "sanctioned entity"}},
+		{"7.654.321-6", ListResult{Reason: "in deny list"}},
+		{"60.803.000-K", ListResult{Allowed: true}},
+		{"1.009-K", ListResult{Reason: "not in allow list"}},
+	}
+	for _, tt := range tests {
+		if got := c.Check(MustParse(tt.input)); got != tt.want {
+			t.Errorf("Check(%q) = %+v; want %+v", tt.input, got, tt.want)
+		}
+	}
+
+	// An invalid list keeps the previous one
+	err := c.SetDenyList(strings.NewReader("1.009-K\n12.345.678-0\n"))
+	if !errors.Is(err, ErrInvalidDV) || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("SetDenyList(invalid) error = %v; want line 2: %v", err, ErrInvalidDV)
+	}
+	if got := c.Check(MustParse("7.654.321-6")); got.Allowed {
+		t.Errorf("Check() after failed reload = %+v; want the previous deny list", got)
+	}
+}
+
+func TestListChecker_Watch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "deny.txt")
+	if err := os.WriteFile(path, []byte("12.345.678-5\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	c := NewListChecker()
+	if err := c.LoadDenyFile(path); err != nil {
+		t.Fatalf("LoadDenyFile() error = %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errs := make(chan error, 10)
+	go c.Watch(ctx, 5*time.Millisecond, func(err error) { errs <- err })
+
+	update := func(content string) {
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		// Make sure the change is visible even with a coarse clock
+		later := time.Now().Add(time.Minute)
+		os.Chtimes(path, later, later)
+	}
+	waitFor := func(input string, allowed bool) {
+		t.Helper()
+		for deadline := time.Now().Add(2 * time.Second); time.Now().Before(deadline); {
+			if c.Check(MustParse(input)).Allowed == allowed {
+				return
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+		t.Fatalf("Check(%q).Allowed did not become %v", input, allowed)
+	}
+
+	update("1.009-K\n")
+	waitFor("12.345.678-5", true)
+	waitFor("1.009-K", false)
+
+	update("not a rut\n")
+	select {
+	case err := <-errs:
+		if !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("Watch() error = %v; want %v", err, ErrInvalidFormat)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Watch() did not report the invalid file")
+	}
+	if c.Check(MustParse("1.009-K")).Allowed {
+		t.Errorf("Check() after invalid file = allowed; want the previous deny list")
+	}
+}
+
+func TestListChecker_OnReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "allow.txt")
+	if err := os.WriteFile(path, []byte("12.345.678-5\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	c := NewListChecker()
+	if err := c.LoadAllowFile(path); err != nil {
+		t.Fatalf("LoadAllowFile() error = %v", err)
+	}
+
+	type key struct{}
+	ctx, cancel := context.WithCancel(context.WithValue(context.Background(), key{}, "watch"))
+	defer cancel()
+	reloads := make(chan ListReload, 10)
+	c.OnReload(func(ctx context.Context, ev ListReload) {
+		if ctx.Value(key{}) != "watch" {
+			t.Errorf("OnReload() context is not the Watch context")
+		}
+		reloads <- ev
+	})
+	go c.Watch(ctx, 5*time.Millisecond, nil)
+
+	update := func(content string) ListReload {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		later := time.Now().Add(time.Minute)
+		os.Chtimes(path, later, later)
+		select {
+		case ev := <-reloads:
+			return ev
+		case <-time.After(2 * time.Second):
+			t.Fatal("Watch() did not reload the file")
+			return ListReload{}
+		}
+	}
+
+	ev := update("12.345.678-5\n1.009-K\n")
+	if ev.Path != path || ev.Deny || ev.Entries != 2 || ev.Err != nil || ev.Start.IsZero() {
+		t.Errorf("OnReload() got %+v; want the allow list of %s with 2 entries", ev, path)
+	}
+	ev = update("not a rut\n")
+	if !errors.Is(ev.Err, ErrInvalidFormat) || ev.Entries != 0 {
+		t.Errorf("OnReload() got %+v; want Err %v and no entries", ev, ErrInvalidFormat)
+	}
+}
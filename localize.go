@@ -0,0 +1,93 @@
+package rut
+
+import (
+	"strings"
+	"sync"
+)
+
+// Catalog holds the user facing messages of a language, by error code.
+type Catalog map[ErrorCode]string
+
+var (
+	catalogsMu sync.RWMutex
+	catalogs   = map[string]Catalog{
+		"es": {
+			CodeEmpty:             "Ingrese un RUT",
+			CodeTooShort:          "El RUT es demasiado corto",
+			CodeTooLong:           "El RUT es demasiado largo",
+			CodeInvalidChar:       "El RUT contiene caracteres no válidos",
+			CodeMisplacedK:        "La K solo puede ser el dígito verificador",
+			CodeInvalidSeparators: "Los puntos o el guion del RUT están mal ubicados",
+			CodeInvalidParts:      "El número o el dígito verificador no son válidos",
+			CodeInvalidFormat:     "El formato del RUT no es válido",
+			CodeInvalidDV:         "El dígito verificador no corresponde",
+			CodeRequired:          "El RUT es obligatorio",
+			CodeNotPerson:         "El RUT no corresponde a una persona natural",
+			CodeNotCompany:        "El RUT no corresponde a una empresa",
+			CodeOutOfRange:        "El RUT está fuera del rango permitido",
+			CodeKindNotAllowed:    "Este tipo de RUT no está permitido",
+			CodeDenied:            "El RUT no está autorizado",
+			CodeFictitious:        "El RUT parece ficticio",
+			CodeReserved:          "El RUT está reservado y no puede usarse",
+		},
+		"en": {
+			CodeEmpty:             "Enter a RUT",
+			CodeTooShort:          "The RUT is too short",
+			CodeTooLong:           "The RUT is too long",
+			CodeInvalidChar:       "The RUT contains invalid characters",
+			CodeMisplacedK:        "K can only be the check digit",
+			CodeInvalidSeparators: "The dots or dash of the RUT are misplaced",
+			CodeInvalidParts:      "The number or the check digit is not valid",
+			CodeInvalidFormat:     "The RUT format is not valid",
+			CodeInvalidDV:         "The check digit does not match",
+			CodeRequired:          "The RUT is required",
+			CodeNotPerson:         "The RUT does not belong to a natural person",
+			CodeNotCompany:        "The RUT does not belong to a company",
+			CodeOutOfRange:        "The RUT is outside the allowed range",
+			CodeKindNotAllowed:    "This kind of RUT is not allowed",
+			CodeDenied:            "The RUT is not authorized",
+			CodeFictitious:        "The RUT looks fictitious",
+			CodeReserved:          "The RUT is reserved and cannot be used",
+		},
+	}
+)
+
+// RegisterCatalog adds or replaces the messages of a language, such as
+// "pt" or "es-AR". Messages missing from c fall back to the base language
+// and then to English.
+func RegisterCatalog(lang string, c Catalog) {
+	catalogsMu.Lock()
+	defer catalogsMu.Unlock()
+	catalogs[strings.ToLower(lang)] = c
+}
+
+// Localize returns a user facing message for err in lang, a BCP 47 tag
+// such as "es-CL" or "en". Spanish and English are built in, and
+// RegisterCatalog adds others. A tag without a catalog uses its base
+// language, then English; errors without a code return err.Error(), and
+// nil returns "".
+func Localize(err error, lang string) string {
+	if err == nil {
+		return ""
+	}
+	code := CodeOf(err)
+	if code == "" {
+		return err.Error()
+	}
+
+	catalogsMu.RLock()
+	defer catalogsMu.RUnlock()
+	lang = strings.ToLower(lang)
+	base, _, _ := strings.Cut(lang, "-")
+	for _, l := range []string{lang, base, "en"} {
+		if msg, ok := catalogs[l][code]; ok {
+			return msg
+		}
+	}
+	return err.Error()
+}
+
+// Localized returns the message of e in lang, see Localize.
+func (e *ParseError) Localized(lang string) string {
+	return Localize(e, lang)
+}
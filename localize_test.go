@@ -0,0 +1,84 @@
+package rut
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestLocalize(t *testing.T) {
+	_, dvErr := ParseStrict("12.345.678-0")
+	_, charErr := Parse("12.3a5.678-5")
+
+	tests := []struct {
+		err  error
+		lang string
+		want string
+	}{
+		{dvErr, "es-CL", "El dígito verificador no corresponde"},
+		{dvErr, "es", "El dígito verificador no corresponde"},
+		{dvErr, "ES-cl", "El dígito verificador no corresponde"},
+		{dvErr, "en-US", "The check digit does not match"},
+		{dvErr, "fr", "The check digit does not match"},
+		{charErr, "es-CL", "El RUT contiene caracteres no válidos"},
+		{fmt.Errorf("field: %w", ErrNotCompany), "es-CL", "El RUT no corresponde a una empresa"},
+		{&FieldError{Field: "Emisor", Err: ErrRequired}, "es", "El RUT es obligatorio"},
+		{ErrDenied, "en", "The RUT is not authorized"},
+		{errors.New("boom"), "es", "boom"},
+		{nil, "es", ""},
+	}
+
+	for _, tt := range tests {
+		if got := Localize(tt.err, tt.lang); got != tt.want {
+			t.Errorf("Localize(%v, %q) = %q; want %q", tt.err, tt.lang, got, tt.want)
+		}
+	}
+}
+
+func TestParseError_Localized(t *testing.T) {
+	_, err := Parse("")
+	var perr *ParseError
+	if !errors.As(err, &perr) {
+		t.Fatalf("Parse() error = %v; want a *ParseError", err)
+	}
+	if got := perr.Localized("es-CL"); got != "Ingrese un RUT" {
+		t.Errorf("Localized() = %q; want %q", got, "Ingrese un RUT")
+	}
+}
+
+func TestRegisterCatalog(t *testing.T) {
+	RegisterCatalog("pt-BR", Catalog{CodeInvalidDV: "Dígito verificador inválido"})
+	defer func() {
+		catalogsMu.Lock()
+		delete(catalogs, "pt-br")
+		catalogsMu.Unlock()
+	}()
+
+	if got := Localize(ErrInvalidDV, "pt-BR"); got != "Dígito verificador inválido" {
+		t.Errorf("Localize(pt-BR) = %q", got)
+	}
+	// Missing messages fall back to English
+	if got := Localize(ErrTooLong, "pt-BR"); got != "The RUT is too long" {
+		t.Errorf("Localize(pt-BR) = %q; want the English message", got)
+	}
+}
+
+func TestCodeOf(t *testing.T) {
+	_, err := Parse("12.34K.678-5")
+	tests := []struct {
+		err  error
+		want ErrorCode
+	}{
+		{err, CodeMisplacedK},
+		{ErrInvalidFormat, CodeInvalidFormat},
+		{fmt.Errorf("x: %w", ErrFictitious), CodeFictitious},
+		{errors.New("boom"), ""},
+		{nil, ""},
+	}
+
+	for _, tt := range tests {
+		if got := CodeOf(tt.err); got != tt.want {
+			t.Errorf("CodeOf(%v) = %q; want %q", tt.err, got, tt.want)
+		}
+	}
+}
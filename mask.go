@@ -0,0 +1,48 @@
+package rut
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// Mask returns the RUT in FormatComplete style with every digit of the
+// number but the last three replaced by '*', as in "**.***.678-5", for
+// receipts, logs and screens that must not show the full RUT. The zero RUT
+// masks to an empty string.
+func (r RUT) Mask() string {
+	if r.IsZero() {
+		return ""
+	}
+	var buf [25]byte
+	b := r.AppendFormat(buf[:0], FormatComplete)
+
+	// Skip the check digit, the dash and the three visible digits
+	for i, visible := len(b)-3, 3; i >= 0; i-- {
+		if b[i] == '.' {
+			continue
+		}
+		if visible > 0 {
+			visible--
+			continue
+		}
+		b[i] = '*'
+	}
+	return string(b)
+}
+
+// Pseudonym returns a keyed hash of the RUT, the first 16 bytes of its
+// HMAC-SHA256 in hex, for correlating logs and traces by subject without
+// storing the RUT itself. There are only about a billion RUTs, so a plain
+// hash is reversed by brute force in minutes: key must be a secret of at
+// least 32 random bytes, shared by the services that need to correlate.
+// The zero RUT has an empty pseudonym.
+func (r RUT) Pseudonym(key []byte) string {
+	if r.IsZero() {
+		return ""
+	}
+	mac := hmac.New(sha256.New, key)
+	var buf [25]byte
+	mac.Write(r.AppendFormat(buf[:0], FormatEscaped))
+	return hex.EncodeToString(mac.Sum(nil)[:16])
+}
@@ -0,0 +1,43 @@
+package rut
+
+import "testing"
+
+func TestRUT_Mask(t *testing.T) {
+	tests := []struct {
+		input    RUT
+		expected string
+	}{
+		{MustParse("12.345.678-5"), "**.***.678-5"},
+		{MustParse("1.009-K"), "*.009-K"},
+		{RUT{Number: 123, DV: CalculateDV(123)}, "123-" + string(CalculateDV(123))},
+		{RUT{}, ""},
+	}
+
+	for _, tt := range tests {
+		if got := tt.input.Mask(); got != tt.expected {
+			t.Errorf("Mask(%v) = %q; want %q", tt.input, got, tt.expected)
+		}
+	}
+}
+
+func TestRUT_Pseudonym(t *testing.T) {
+	key := []byte("0123456789abcdef0123456789abcdef")
+	r := MustParse("12.345.678-5")
+
+	got := r.Pseudonym(key)
+	if len(got) != 32 {
+		t.Fatalf("Pseudonym() = %q; want 32 hex digits", got)
+	}
+	if again := MustParse("12345678-5").Pseudonym(key); again != got {
+		t.Errorf("Pseudonym() = %q for another format; want %q", again, got)
+	}
+	if other := r.Pseudonym([]byte("another key")); other == got {
+		t.Errorf("Pseudonym() does not depend on the key")
+	}
+	if other := MustParse("1.009-K").Pseudonym(key); other == got {
+		t.Errorf("Pseudonym() is the same for two RUTs")
+	}
+	if got := (RUT{}).Pseudonym(key); got != "" {
+		t.Errorf("RUT{}.Pseudonym() = %q; want empty", got)
+	}
+}
@@ -0,0 +1,125 @@
+package rut
+
+import (
+	"errors"
+	"expvar"
+	"time"
+)
+
+// Results of a validation, passed to Metrics.Inc.
+const (
+	ResultValid         = "valid"
+	ResultEmpty         = "empty"
+	ResultTooShort      = "too_short"
+	ResultTooLong       = "too_long"
+	ResultInvalidFormat = "invalid_format"
+	ResultInvalidDV     = "invalid_dv"
+	ResultOther         = "other"
+)
+
+// Results lists every result ResultOf returns, for backends that export
+// all series up front.
+var Results = []string{ResultValid, ResultEmpty, ResultTooShort, ResultTooLong,
+	ResultInvalidFormat, ResultInvalidDV, ResultOther}
+
+// Metrics receives the measurements of an InstrumentedParser. Adapters for
+// statsd, Datadog and similar backends implement it; ExpvarMetrics and the
+// rutprom module are included. Implementations must be safe for concurrent
+// use.
+type Metrics interface {
+	// Inc counts a validation with the given result.
+	Inc(result string)
+	// Observe records how long a validation took.
+	Observe(d time.Duration)
+}
+
+// ResultOf returns the result of a validation that returned err.
+func ResultOf(err error) string {
+	switch {
+	case err == nil:
+		return ResultValid
+	case errors.Is(err, ErrEmptyRUT):
+		return ResultEmpty
+	case errors.Is(err, ErrTooShort):
+		return ResultTooShort
+	case errors.Is(err, ErrTooLong):
+		return ResultTooLong
+	case errors.Is(err, ErrInvalidFormat):
+		return ResultInvalidFormat
+	case errors.Is(err, ErrInvalidDV):
+		return ResultInvalidDV
+	default:
+		return ResultOther
+	}
+}
+
+// InstrumentedParser parses and validates RUTs like a Parser, reporting
+// every call to a Metrics. It is safe for concurrent use.
+type InstrumentedParser struct {
+	parser  *Parser
+	metrics Metrics
+}
+
+// Instrument returns an InstrumentedParser wrapping p, or a parser with the
+// default rules if p is nil.
+func Instrument(p *Parser, m Metrics) *InstrumentedParser {
+	if p == nil {
+		p = NewParser()
+	}
+	return &InstrumentedParser{parser: p, metrics: m}
+}
+
+// Parse parses s with the wrapped parser and verifies the check digit,
+// returning ErrInvalidDV if it does not match.
+func (ip *InstrumentedParser) Parse(s string) (RUT, error) {
+	start := time.Now()
+	r, err := ip.parser.Parse(s)
+	if err == nil && !r.Validate() {
+		r, err = RUT{}, ErrInvalidDV
+	}
+	ip.metrics.Observe(time.Since(start))
+	ip.metrics.Inc(ResultOf(err))
+	return r, err
+}
+
+// Validate reports whether s parses and has a valid check digit.
+func (ip *InstrumentedParser) Validate(s string) bool {
+	_, err := ip.Parse(s)
+	return err == nil
+}
+
+// ExpvarMetrics is a Metrics publishing an expvar.Map, served as JSON at
+// /debug/vars by the expvar package. The map holds a count per result,
+// plus the number of observations and their total duration in
+// nanoseconds under "parse_count" and "parse_ns".
+type ExpvarMetrics struct {
+	m *expvar.Map
+}
+
+// NewExpvarMetrics publishes a new map with the given name. Like
+// expvar.NewMap, it panics if the name is already in use.
+func NewExpvarMetrics(name string) *ExpvarMetrics {
+	m := expvar.NewMap(name)
+	for _, result := range Results {
+		m.Add(result, 0)
+	}
+	m.Add("parse_count", 0)
+	m.Add("parse_ns", 0)
+	return &ExpvarMetrics{m: m}
+}
+
+// Inc implements Metrics.
+func (e *ExpvarMetrics) Inc(result string) {
+	e.m.Add(result, 1)
+}
+
+// Observe implements Metrics.
+func (e *ExpvarMetrics) Observe(d time.Duration) {
+	e.m.Add("parse_count", 1)
+	e.m.Add("parse_ns", int64(d))
+}
+
+// Map returns the published map.
+func (e *ExpvarMetrics) Map() *expvar.Map {
+	return e.m
+}
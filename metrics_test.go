@@ -0,0 +1,110 @@
+package rut
+
+import (
+	"errors"
+	"expvar"
+	"sync"
+	"testing"
+	"time"
+)
+
+// recordingMetrics is a Metrics keeping every call.
+type recordingMetrics struct {
+	mu      sync.Mutex
+	results map[string]int
+	n       int
+}
+
+func (m *recordingMetrics) Inc(result string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.results == nil {
+		m.results = make(map[string]int)
+	}
+	m.results[result]++
+}
+
+func (m *recordingMetrics) Observe(time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.n++
+}
+
+func TestInstrument(t *testing.T) {
+	m := &recordingMetrics{}
+	p := Instrument(nil, m)
+
+	inputs := []string{"12.345.678-5", "60803000-k", "12.345.678-0", "", "123", "abc"}
+	for _, input := range inputs {
+		p.Parse(input)
+	}
+	if p.Validate("12.345.678-0") {
+		t.Error("Validate(wrong check digit) = true")
+	}
+
+	want := map[string]int{
+		ResultValid:         2,
+		ResultInvalidDV:     2,
+		ResultEmpty:         1,
+		ResultTooShort:      1,
+		ResultInvalidFormat: 1,
+	}
+	for result, n := range want {
+		if m.results[result] != n {
+			t.Errorf("results[%q] = %d; want %d", result, m.results[result], n)
+		}
+	}
+	if m.n != len(inputs)+1 {
+		t.Errorf("observations = %d; want %d", m.n, len(inputs)+1)
+	}
+}
+
+func TestInstrument_Parser(t *testing.T) {
+	p := Instrument(NewParser(WithStyles(FormatWithDash)), &recordingMetrics{})
+	if _, err := p.Parse("12.345.678-5"); !errors.Is(err, ErrInvalidFormat) {
+		t.Errorf("Parse() error = %v; want %v", err, ErrInvalidFormat)
+	}
+}
+
+func TestResultOf(t *testing.T) {
+	tests := []struct {
+		err  error
+		want string
+	}{
+		{nil, ResultValid},
+		{ErrEmptyRUT, ResultEmpty},
+		{ErrTooShort, ResultTooShort},
+		{ErrTooLong, ResultTooLong},
+		{ErrInvalidFormat, ResultInvalidFormat},
+		{ErrInvalidDV, ResultInvalidDV},
+		{errors.New("boom"), ResultOther},
+	}
+
+	for _, tt := range tests {
+		if got := ResultOf(tt.err); got != tt.want {
+			t.Errorf("ResultOf(%v) = %q; want %q", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestExpvarMetrics(t *testing.T) {
+	m := NewExpvarMetrics("rut_test_validations")
+	if expvar.Get("rut_test_validations") != m.Map() {
+		t.Fatal("NewExpvarMetrics() did not publish its map")
+	}
+
+	p := Instrument(nil, m)
+	p.Validate("12.345.678-5")
+	p.Validate("12.345.678-0")
+
+	for key, want := range map[string]string{
+		ResultValid:     "1",
+		ResultInvalidDV: "1",
+		ResultEmpty:     "0",
+		"parse_count":   "2",
+	} {
+		if got := m.Map().Get(key); got == nil || got.String() != want {
+			t.Errorf("Get(%q) = %v; want %s", key, got, want)
+		}
+	}
+}
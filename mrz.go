@@ -0,0 +1,170 @@
+package rut
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// MRZ errors
+var (
+	ErrInvalidMRZ    = errors.New("rut: invalid MRZ")
+	ErrMRZCheckDigit = errors.New("rut: MRZ check digit mismatch")
+)
+
+// MRZ is the machine readable zone of a cédula (TD1, three lines of 30
+// characters) or a passport (TD3, two lines of 44 characters), as defined
+// by ICAO Doc 9303.
+type MRZ struct {
+	Format         string // "TD1" or "TD3"
+	DocumentCode   string // Such as "ID", "IN" or "P"
+	Issuer         string // Issuing state, such as "CHL"
+	DocumentNumber string
+	Surnames       string // Separated by spaces
+	GivenNames     string // Separated by spaces
+	Nationality    string
+	BirthDate      time.Time
+	Sex            string // "M", "F" or ""
+	Expiry         time.Time
+	RUN            RUT // Zero if the optional data holds no RUN
+}
+
+// ParseMRZ parses the MRZ of a Chilean cédula or passport, as read by an
+// OCR scanner, with lines separated by newlines. All the MRZ check digits
+// are verified, returning ErrMRZCheckDigit if one does not match.
+//
+// Chilean documents carry the RUN in the optional data, as the number, a
+// '<' filler and the check digit ("12345678<5"), or in the personal number
+// field of passports, also without filler. For documents issued by Chile
+// the RUN is returned in MRZ.RUN and its own check digit verified; for
+// other documents it is left zero.
+func ParseMRZ(s string) (MRZ, error) {
+	lines := strings.Fields(strings.ToUpper(s))
+	switch {
+	case len(lines) == 3 && len(lines[0]) == 30 && len(lines[1]) == 30 && len(lines[2]) == 30:
+		return parseTD1(lines)
+	case len(lines) == 2 && len(lines[0]) == 44 && len(lines[1]) == 44:
+		return parseTD3(lines)
+	}
+	return MRZ{}, ErrInvalidMRZ
+}
+
+func parseTD1(l []string) (MRZ, error) {
+	m := MRZ{
+		Format:         "TD1",
+		DocumentCode:   mrzText(l[0][0:2]),
+		Issuer:         mrzText(l[0][2:5]),
+		DocumentNumber: mrzText(l[0][5:14]),
+		Sex:            mrzText(l[1][7:8]),
+		Nationality:    mrzText(l[1][15:18]),
+	}
+	m.Surnames, m.GivenNames = mrzName(l[2])
+
+	checks := []string{l[0][5:15], l[1][0:7], l[1][8:15],
+		l[0][5:30] + l[1][0:7] + l[1][8:15] + l[1][18:29] + l[1][29:30]}
+	if err := m.parseCommon(checks, l[1][0:6], l[1][8:14]); err != nil {
+		return MRZ{}, err
+	}
+	return m, m.findRUN(l[1][18:29], l[0][15:30])
+}
+
+func parseTD3(l []string) (MRZ, error) {
+	m := MRZ{
+		Format:         "TD3",
+		DocumentCode:   mrzText(l[0][0:2]),
+		Issuer:         mrzText(l[0][2:5]),
+		DocumentNumber: mrzText(l[1][0:9]),
+		Nationality:    mrzText(l[1][10:13]),
+		Sex:            mrzText(l[1][20:21]),
+	}
+	m.Surnames, m.GivenNames = mrzName(l[0][5:])
+
+	checks := []string{l[1][0:10], l[1][13:20], l[1][21:28],
+		l[1][0:10] + l[1][13:20] + l[1][21:43] + l[1][43:44]}
+	if l[1][28:42] != strings.Repeat("<", 14) || l[1][42] != '<' {
+		checks = append(checks, l[1][28:43])
+	}
+	if err := m.parseCommon(checks, l[1][13:19], l[1][21:27]); err != nil {
+		return MRZ{}, err
+	}
+	return m, m.findRUN(l[1][28:42])
+}
+
+// parseCommon verifies the check digits, each one the last character of
+// its field, and parses the dates.
+func (m *MRZ) parseCommon(checks []string, birth, expiry string) error {
+	for _, f := range checks {
+		if mrzCheckDigit(f[:len(f)-1]) != f[len(f)-1] {
+			return ErrMRZCheckDigit
+		}
+	}
+
+	var err error
+	if m.BirthDate, err = time.Parse("060102", birth); err != nil {
+		return ErrInvalidMRZ
+	}
+	if m.BirthDate.After(time.Now()) {
+		m.BirthDate = m.BirthDate.AddDate(-100, 0, 0)
+	}
+	if m.Expiry, err = time.Parse("060102", expiry); err != nil {
+		return ErrInvalidMRZ
+	}
+	return nil
+}
+
+// findRUN looks for a RUN in the optional data fields of Chilean
+// documents, in order.
+func (m *MRZ) findRUN(fields ...string) error {
+	if m.Issuer != "CHL" {
+		return nil
+	}
+	for _, f := range fields {
+		v := strings.TrimRight(f, "<")
+		n := strings.IndexFunc(v, func(c rune) bool { return c < '0' || c > '9' })
+		if n < 0 {
+			n = len(v)
+		}
+		switch {
+		case n >= 1 && n+2 == len(v) && v[n] == '<' && (v[n+1] == 'K' || v[n+1] >= '0' && v[n+1] <= '9'):
+			v = v[:n] + "-" + v[n+1:]
+		case n == len(v) && n >= minLength-1 || n+1 == len(v) && v[n] == 'K':
+		default:
+			continue
+		}
+		r, err := ParseStrict(v)
+		if err != nil {
+			return err
+		}
+		m.RUN = r
+		return nil
+	}
+	return nil
+}
+
+// mrzCheckDigit computes the ICAO 9303 check digit of s.
+func mrzCheckDigit(s string) byte {
+	weights := [3]int{7, 3, 1}
+	sum := 0
+	for i := 0; i < len(s); i++ {
+		var v int
+		switch c := s[i]; {
+		case c >= '0' && c <= '9':
+			v = int(c - '0')
+		case c >= 'A' && c <= 'Z':
+			v = int(c-'A') + 10
+		}
+		sum += v * weights[i%3]
+	}
+	return byte(sum%10) + '0'
+}
+
+// mrzText replaces the '<' fillers of a field with spaces and trims them.
+func mrzText(s string) string {
+	return strings.TrimSpace(strings.ReplaceAll(s, "<", " "))
+}
+
+// mrzName splits the name field into surnames and given names.
+func mrzName(s string) (surnames, given string) {
+	surnames, given, _ = strings.Cut(s, "<<")
+	return mrzText(surnames), mrzText(given)
+}
@@ -0,0 +1,92 @@
+package rut
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseMRZ(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  MRZ
+	}{
+		{
+			"cédula",
+			"INCHL1001234562<<<<<<<<<<<<<<<\n8502153F3002153CHL12345678<5<9\nGONZALEZ<MUNOZ<<MARIA<JOSE<<<<\n",
+			MRZ{
+				Format:         "TD1",
+				DocumentCode:   "IN",
+				Issuer:         "CHL",
+				DocumentNumber: "100123456",
+				Surnames:       "GONZALEZ MUNOZ",
+				GivenNames:     "MARIA JOSE",
+				Nationality:    "CHL",
+				BirthDate:      time.Date(1985, 2, 15, 0, 0, 0, 0, time.UTC),
+				Sex:            "F",
+				Expiry:         time.Date(2030, 2, 15, 0, 0, 0, 0, time.UTC),
+				RUN:            RUT{Number: 12_345_678, DV: '5'},
+			},
+		},
+		{
+			"passport",
+			"P<CHLSOTO<<JUAN<PABLO<<<<<<<<<<<<<<<<<<<<<<<\r\nF123456789CHL0101011M2812313123456785<<<<<32",
+			MRZ{
+				Format:         "TD3",
+				DocumentCode:   "P",
+				Issuer:         "CHL",
+				DocumentNumber: "F12345678",
+				Surnames:       "SOTO",
+				GivenNames:     "JUAN PABLO",
+				Nationality:    "CHL",
+				BirthDate:      time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC),
+				Sex:            "M",
+				Expiry:         time.Date(2028, 12, 31, 0, 0, 0, 0, time.UTC),
+				RUN:            RUT{Number: 12_345_678, DV: '5'},
+			},
+		},
+		{
+			"foreign passport",
+			"P<ARGPEREZ<<ANA<<<<<<<<<<<<<<<<<<<<<<<<<<<<<\nX9876543<5ARG0101011<2812313<<<<<<<<<<<<<<<4",
+			MRZ{
+				Format:         "TD3",
+				DocumentCode:   "P",
+				Issuer:         "ARG",
+				DocumentNumber: "X9876543",
+				Surnames:       "PEREZ",
+				GivenNames:     "ANA",
+				Nationality:    "ARG",
+				BirthDate:      time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC),
+				Expiry:         time.Date(2028, 12, 31, 0, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseMRZ(tt.input)
+		if err != nil || got != tt.want {
+			t.Errorf("%s: ParseMRZ() = %+v, %v; want %+v, nil", tt.name, got, err, tt.want)
+		}
+	}
+}
+
+func TestParseMRZ_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  error
+	}{
+		{"empty", "", ErrInvalidMRZ},
+		{"short line", "INCHL1001234562<<<<<<<<<<<<<<\n8502153F3002153CHL12345678<5<9\nGONZALEZ<MUNOZ<<MARIA<JOSE<<<<", ErrInvalidMRZ},
+		{"document number", "INCHL1001234572<<<<<<<<<<<<<<<\n8502153F3002153CHL12345678<5<9\nGONZALEZ<MUNOZ<<MARIA<JOSE<<<<", ErrMRZCheckDigit},
+		{"composite", "INCHL1001234562<<<<<<<<<<<<<<<\n8502153F3002153CHL12345678<5<8\nGONZALEZ<MUNOZ<<MARIA<JOSE<<<<", ErrMRZCheckDigit},
+		{"RUN check digit", "INCHL1001234562<<<<<<<<<<<<<<<\n8502153F3002153CHL12345678<0<4\nGONZALEZ<MUNOZ<<MARIA<JOSE<<<<", ErrInvalidDV},
+	}
+
+	for _, tt := range tests {
+		if _, err := ParseMRZ(tt.input); !errors.Is(err, tt.want) {
+			t.Errorf("%s: ParseMRZ() error = %v; want %v", tt.name, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package rut
+
+import "encoding/binary"
+
+// MarshalMsgpack implements the msgpack.Marshaler interface of
+// github.com/vmihailenco/msgpack, which only uses built-in types. The RUT
+// is encoded as the number alone in the smallest unsigned integer format,
+// at most 5 bytes, and the zero RUT as nil.
+func (r RUT) MarshalMsgpack() ([]byte, error) {
+	n := r.Number
+	switch {
+	case r.IsZero():
+		return []byte{0xc0}, nil
+	case n <= 0 || n > MaxSetNumber:
+		return nil, ErrInvalidFormat
+	case n <= 0x7f:
+		return []byte{byte(n)}, nil
+	case n <= 0xffff:
+		return binary.BigEndian.AppendUint16([]byte{0xcd}, uint16(n)), nil
+	}
+	return binary.BigEndian.AppendUint32([]byte{0xce}, uint32(n)), nil
+}
+
+// UnmarshalMsgpack implements the msgpack.Unmarshaler interface of
+// github.com/vmihailenco/msgpack. It accepts integers, whose check digit is
+// computed, and strings in any format supported by Parse, returning
+// ErrInvalidDV for a wrong check digit. Nil decodes to the zero RUT.
+func (r *RUT) UnmarshalMsgpack(data []byte) error {
+	if len(data) == 0 {
+		return ErrInvalidEncoding
+	}
+
+	c, body := data[0], data[1:]
+	var n int64
+	switch {
+	case c == 0xc0 && len(body) == 0:
+		*r = RUT{}
+		return nil
+	case c <= 0x7f && len(body) == 0:
+		n = int64(c)
+	case c == 0xcc && len(body) == 1:
+		n = int64(body[0])
+	case c == 0xcd && len(body) == 2:
+		n = int64(binary.BigEndian.Uint16(body))
+	case c == 0xce && len(body) == 4:
+		n = int64(binary.BigEndian.Uint32(body))
+	case c == 0xcf && len(body) == 8:
+		n = int64(min(binary.BigEndian.Uint64(body), MaxSetNumber+1))
+	case c == 0xd2 && len(body) == 4:
+		n = int64(int32(binary.BigEndian.Uint32(body)))
+	case c == 0xd3 && len(body) == 8:
+		n = int64(binary.BigEndian.Uint64(body))
+	case c >= 0xa0 && c <= 0xbf && len(body) == int(c-0xa0):
+		return r.UnmarshalText(body)
+	case c == 0xd9 && len(body) >= 1 && len(body)-1 == int(body[0]):
+		return r.UnmarshalText(body[1:])
+	default:
+		return ErrInvalidEncoding
+	}
+	return r.unmarshalNumber(n)
+}
@@ -0,0 +1,56 @@
+package rut
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestRUT_Msgpack(t *testing.T) {
+	tests := []struct {
+		r    RUT
+		want []byte
+	}{
+		{RUT{}, []byte{0xc0}},
+		{RUT{Number: 19, DV: CalculateDV(19)}, []byte{0x13}},
+		{RUT{Number: 1009, DV: 'K'}, []byte{0xcd, 0x03, 0xf1}},
+		{RUT{Number: 12345678, DV: '5'}, []byte{0xce, 0x00, 0xbc, 0x61, 0x4e}},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.r.MarshalMsgpack()
+		if err != nil || !bytes.Equal(got, tt.want) {
+			t.Errorf("MarshalMsgpack(%v) = %x, %v; want %x, nil", tt.r, got, err, tt.want)
+			continue
+		}
+		var back RUT
+		if err := back.UnmarshalMsgpack(got); err != nil || back != tt.r {
+			t.Errorf("UnmarshalMsgpack(%x) = %v, %v; want %v, nil", got, back, err, tt.r)
+		}
+	}
+}
+
+func TestRUT_UnmarshalMsgpack(t *testing.T) {
+	tests := []struct {
+		data    []byte
+		want    RUT
+		wantErr error
+	}{
+		{[]byte("\xac12.345.678-5"), MustParse("12.345.678-5"), nil},
+		{[]byte("\xd9\x0612345k"), RUT{}, ErrInvalidDV},
+		{[]byte{0xd3, 0, 0, 0, 0, 0, 0, 0x03, 0xf1}, MustParse("1.009-K"), nil},
+		{[]byte{0xcf, 0xff, 0, 0, 0, 0, 0, 0, 0}, RUT{}, ErrInvalidFormat},
+		{[]byte{0xd2, 0xff, 0xff, 0xff, 0xff}, RUT{}, ErrInvalidFormat},
+		{[]byte{0xce, 0x00, 0xbc}, RUT{}, ErrInvalidEncoding},
+		{[]byte{0xc3}, RUT{}, ErrInvalidEncoding},
+		{nil, RUT{}, ErrInvalidEncoding},
+	}
+
+	for _, tt := range tests {
+		var got RUT
+		err := got.UnmarshalMsgpack(tt.data)
+		if !errors.Is(err, tt.wantErr) || got != tt.want {
+			t.Errorf("UnmarshalMsgpack(%x) = %v, %v; want %v, %v", tt.data, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package rut
+
+import "strings"
+
+// ocrDigit maps characters commonly confused by OCR engines to the digit
+// they most likely represent. 'K' is left untouched since it is a valid
+// check digit.
+func ocrDigit(r rune) rune {
+	switch r {
+	case 'O', 'o', 'Q', 'D':
+		return '0'
+	case 'I', 'l', '|', '!':
+		return '1'
+	case 'Z', 'z':
+		return '2'
+	case 'S', 's':
+		return '5'
+	case 'G', 'b':
+		return '6'
+	case 'B':
+		return '8'
+	case 'g':
+		return '9'
+	}
+	return r
+}
+
+// NormalizeOCR replaces characters that OCR engines commonly confuse with
+// digits (O→0, I/l→1, B→8, S→5, ...) so the result can be parsed.
+// Characters without a known confusion are returned unchanged.
+func NormalizeOCR(s string) string {
+	return strings.Map(ocrDigit, s)
+}
+
+// ParseOCR is like Parse but first applies NormalizeOCR, for RUTs read
+// from scanned documents.
+func ParseOCR(s string) (RUT, error) {
+	return Parse(NormalizeOCR(s))
+}
@@ -0,0 +1,49 @@
+package rut
+
+import (
+	"testing"
+)
+
+func TestNormalizeOCR(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"12.345.678-5", "12.345.678-5"},
+		{"I2.345.67B-S", "12.345.678-5"},
+		{"l.OO9-K", "1.009-K"},
+		{"1.009-k", "1.009-k"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if got := NormalizeOCR(tt.input); got != tt.expected {
+			t.Errorf("NormalizeOCR(%q) = %q; want %q", tt.input, got, tt.expected)
+		}
+	}
+}
+
+func TestParseOCR(t *testing.T) {
+	tests := []struct {
+		input   string
+		wantNum int
+		wantDV  byte
+		wantErr bool
+	}{
+		{"I2.345.67B-S", 12345678, '5', false},
+		{"7.6S4.32l-6", 7654321, '6', false},
+		{"l.OO9-K", 1009, 'K', false},
+		{"12.345.678-X", 0, 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseOCR(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseOCR(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && (got.Number != tt.wantNum || got.DV != tt.wantDV) {
+			t.Errorf("ParseOCR(%q) = %v; want %d-%c", tt.input, got, tt.wantNum, tt.wantDV)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package rut
+
+import "strings"
+
+// Parser parses RUT strings with a configurable set of rules.
+// A Parser is immutable once created and safe for concurrent use.
+type Parser struct {
+	strict   bool
+	styles   []FormatStyle
+	minLen   int
+	maxLen   int
+	verifyDV bool
+	ocr      bool
+	lenient  bool
+	labels   bool
+}
+
+// Option configures a Parser.
+type Option func(*Parser)
+
+// NewParser returns a Parser configured with the given options.
+// Without options it behaves like Parse.
+func NewParser(opts ...Option) *Parser {
+	p := &Parser{
+		minLen: minLength,
+		maxLen: maxLength,
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// WithStrictSeparators requires separators, when present, to be placed as
+// in FormatComplete, FormatWithDash or FormatSpaces. Inputs such as
+// "1234.5678-5" are rejected with ErrInvalidFormat.
+func WithStrictSeparators() Option {
+	return func(p *Parser) {
+		p.strict = true
+	}
+}
+
+// WithStyles only accepts inputs written in one of the given styles.
+// Other inputs are rejected with ErrInvalidFormat.
+func WithStyles(styles ...FormatStyle) Option {
+	return func(p *Parser) {
+		p.styles = append([]FormatStyle(nil), styles...)
+	}
+}
+
+// WithLength sets the accepted length bounds, counting digits and check
+// digit without separators. Bounds are clamped to the range 2 to 19, or 2
+// to 10 on 32-bit platforms, so numbers above 999.999.999 can be accepted
+// by raising the maximum.
+func WithLength(minLen, maxLen int) Option {
+	return func(p *Parser) {
+		p.minLen = clamp(minLen, 2, maxParserLength)
+		p.maxLen = clamp(maxLen, p.minLen, maxParserLength)
+	}
+}
+
+// WithVerifyDV makes Parse verify the check digit, returning ErrInvalidDV
+// for well formed RUTs whose check digit does not match.
+func WithVerifyDV() Option {
+	return func(p *Parser) {
+		p.verifyDV = true
+	}
+}
+
+// WithOCR applies NormalizeOCR to the input before parsing.
+func WithOCR() Option {
+	return func(p *Parser) {
+		p.ocr = true
+	}
+}
+
+// WithLenientSeparators accepts the dash and space variants common in text
+// copied from PDFs and word processors: en and em dashes, minus signs,
+// non-breaking and other Unicode spaces, zero width characters and
+// surrounding whitespace.
+func WithLenientSeparators() Option {
+	return func(p *Parser) {
+		p.lenient = true
+	}
+}
+
+// WithLabels accepts a caption such as "RUT:", "R.U.T.", "RUN" or
+// "Rol Único Tributario Nº" before the value, and trailing punctuation or
+// text after a comma, as found in form imports and scraped pages.
+func WithLabels() Option {
+	return func(p *Parser) {
+		p.labels = true
+	}
+}
+
+// Parse extracts the number and check digit from a RUT string according
+// to the parser configuration. Errors are *ParseError; if an option
+// rewrote the input before parsing, their position is -1.
+func (p *Parser) Parse(s string) (RUT, error) {
+	r, err := p.parse(s)
+	if perr, ok := err.(*ParseError); ok && perr.Input != s {
+		perr.Input, perr.Pos = s, -1
+	}
+	return r, err
+}
+
+func (p *Parser) parse(s string) (RUT, error) {
+	if p.lenient {
+		s = normalizeSeparators(s)
+	}
+	if p.labels {
+		s = stripLabels(s)
+	}
+	if p.ocr {
+		s = NormalizeOCR(s)
+	}
+
+	r, err := parse(s, p.minLen, p.maxLen)
+	if err != nil {
+		return RUT{}, err
+	}
+
+	if p.strict || p.styles != nil {
+		style, ok := detectStyle(strings.TrimSpace(s))
+		if !ok || !p.allowsStyle(style) {
+			return RUT{}, newParseError(s, -1, CodeInvalidSeparators)
+		}
+	}
+
+	if p.verifyDV && !r.Validate() {
+		return RUT{}, dvError(s)
+	}
+	return r, nil
+}
+
+// Validate reports whether s is accepted by the parser and has a valid
+// check digit.
+func (p *Parser) Validate(s string) bool {
+	r, err := p.Parse(s)
+	if err != nil {
+		return false
+	}
+	return r.Validate()
+}
+
+func (p *Parser) allowsStyle(style FormatStyle) bool {
+	if p.styles == nil {
+		return true
+	}
+	for _, s := range p.styles {
+		if s == style {
+			return true
+		}
+	}
+	return false
+}
+
+func clamp(v, lo, hi int) int {
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
@@ -0,0 +1,130 @@
+package rut
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestParser_Default(t *testing.T) {
+	p := NewParser()
+	for _, input := range []string{"12.345.678-5", "12345678-5", "123456785", "1234.5678-5", "1.009-k"} {
+		want, wantErr := Parse(input)
+		got, err := p.Parse(input)
+		if got != want || !errors.Is(err, wantErr) {
+			t.Errorf("NewParser().Parse(%q) = %v, %v; want %v, %v", input, got, err, want, wantErr)
+		}
+	}
+}
+
+func TestParser_Options(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    []Option
+		input   string
+		wantErr error
+	}{
+		{"strict complete", []Option{WithStrictSeparators()}, "12.345.678-5", nil},
+		{"strict dash", []Option{WithStrictSeparators()}, "12345678-5", nil},
+		{"strict escaped", []Option{WithStrictSeparators()}, "123456785", nil},
+		{"strict misplaced dot", []Option{WithStrictSeparators()}, "1234.5678-5", ErrInvalidFormat},
+		{"strict missing dot", []Option{WithStrictSeparators()}, "12.345678-5", ErrInvalidFormat},
+		{"strict misplaced dash", []Option{WithStrictSeparators()}, "1234567-85", ErrInvalidFormat},
+		{"strict dots without dash", []Option{WithStrictSeparators()}, "12.345.6785", ErrInvalidFormat},
+		{"strict leading dot", []Option{WithStrictSeparators()}, ".123.456-5", ErrInvalidFormat},
+		{"strict spaces", []Option{WithStrictSeparators()}, "12 345 678-5", nil},
+		{"strict misplaced space", []Option{WithStrictSeparators()}, "1234 5678-5", ErrInvalidFormat},
+		{"strict mixed separators", []Option{WithStrictSeparators()}, "12.345 678-5", ErrInvalidFormat},
+		{"strict surrounding spaces", []Option{WithStrictSeparators()}, "  12.345.678-5 ", nil},
+		{"styles spaces", []Option{WithStyles(FormatSpaces)}, "12 345 678-5", nil},
+		{"styles spaces rejected", []Option{WithStyles(FormatSpaces)}, "12.345.678-5", ErrInvalidFormat},
+		{"styles allowed", []Option{WithStyles(FormatWithDash)}, "12345678-5", nil},
+		{"styles rejected", []Option{WithStyles(FormatWithDash)}, "12.345.678-5", ErrInvalidFormat},
+		{"styles surrounding spaces", []Option{WithStyles(FormatComplete)}, " 12.345.678-5", nil},
+		{"styles multiple", []Option{WithStyles(FormatWithDash, FormatComplete)}, "12.345.678-5", nil},
+		{"length too short", []Option{WithLength(8, 9)}, "1.009-K", ErrTooShort},
+		{"length too long", []Option{WithLength(5, 8)}, "12.345.678-5", ErrTooLong},
+		{"length relaxed", []Option{WithLength(2, 10)}, "1-9", nil},
+		{"length clamped", []Option{WithLength(0, 99)}, "12345678901234567890", ErrTooLong},
+		{"length beyond 10", []Option{WithLength(5, 12), WithVerifyDV()}, "1.234.567.890-3", nil},
+		{"length beyond 10 strict", []Option{WithLength(5, 12), WithStrictSeparators()}, "1.234.567.890-3", nil},
+		{"verify DV valid", []Option{WithVerifyDV()}, "12.345.678-5", nil},
+		{"verify DV invalid", []Option{WithVerifyDV()}, "12.345.678-0", ErrInvalidDV},
+		{"verify DV malformed", []Option{WithVerifyDV()}, "12.34K.678-5", ErrInvalidFormat},
+		{"lenient en dash", []Option{WithLenientSeparators()}, "12.345.678\u20135", nil},
+		{"lenient em dash", []Option{WithLenientSeparators()}, "12.345.678\u20145", nil},
+		{"lenient minus", []Option{WithLenientSeparators()}, "12.345.678\u22125", nil},
+		{"lenient nbsp", []Option{WithLenientSeparators()}, "12\u00a0345\u00a0678-5", nil},
+		{"lenient whitespace", []Option{WithLenientSeparators()}, "\t 12.345.678-5\r\n", nil},
+		{"lenient zero width", []Option{WithLenientSeparators()}, "\ufeff12.345.678-\u200b5", nil},
+		{"lenient strict", []Option{WithLenientSeparators(), WithStrictSeparators()}, " 12.345.678\u20135 ", nil},
+		{"lenient invalid", []Option{WithLenientSeparators()}, "12.345.678\u00b75", ErrInvalidFormat},
+		{"not lenient", nil, "12.345.678\u20135", ErrInvalidFormat},
+		{"labels RUT", []Option{WithLabels()}, "RUT: 12.345.678-5", nil},
+		{"labels R.U.T.", []Option{WithLabels()}, "R.U.T. 12.345.678-5", nil},
+		{"labels lowercase", []Option{WithLabels()}, "rut n° 12.345.678-5", nil},
+		{"labels RUN", []Option{WithLabels()}, "RUN:12345678-5", nil},
+		{"labels full name", []Option{WithLabels()}, "Rol Único Tributario Nº 12.345.678-5", nil},
+		{"labels suffix", []Option{WithLabels()}, "(RUT 12.345.678-5), Santiago", nil},
+		{"labels strict", []Option{WithLabels(), WithStrictSeparators()}, "RUT: 12.345.678-5.", ErrInvalidFormat},
+		{"labels unknown", []Option{WithLabels()}, "Teléfono: 12.345.678-5", ErrInvalidFormat},
+		{"labels with lenient", []Option{WithLabels(), WithLenientSeparators()}, "RUT:\u00a012.345.678\u20135", nil},
+		{"no labels", nil, "RUT: 12.345.678-5", ErrInvalidFormat},
+		{"OCR", []Option{WithOCR()}, "I2.345.67B-S", nil},
+		{"no OCR", nil, "I2.345.67B-S", ErrInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewParser(tt.opts...).Parse(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Parse(%q) error = %v; want %v", tt.input, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParser_Validate(t *testing.T) {
+	p := NewParser(WithStyles(FormatComplete))
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{"12.345.678-5", true},
+		{"12.345.678-0", false},
+		{"123456785", false},
+	}
+
+	for _, tt := range tests {
+		if got := p.Validate(tt.input); got != tt.expected {
+			t.Errorf("Validate(%q) = %v; want %v", tt.input, got, tt.expected)
+		}
+	}
+}
+
+func TestParser_Concurrent(t *testing.T) {
+	p := NewParser(WithStrictSeparators(), WithVerifyDV())
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if !p.Validate("12.345.678-5") {
+					t.Error("Validate() = false; want true")
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestNormalizeSeparators_Allocs(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		normalizeSeparators("12.345.678-5")
+	})
+	if allocs != 0 {
+		t.Errorf("normalizeSeparators() allocs = %v; want 0", allocs)
+	}
+}
@@ -0,0 +1,57 @@
+package rut
+
+import "strings"
+
+// FormatPartial formats input typed so far in FormatComplete style, for
+// servers that reformat a field on every keystroke (HTMX, LiveView-style
+// UIs). Unlike Format it never fails: characters other than digits and a
+// final K are dropped, leading zeros are removed, input is cut at the
+// maximum length, and the last character is shown as the check digit once
+// there are at least two:
+//
+//	FormatPartial("1")          // "1"
+//	FormatPartial("12")         // "1-2"
+//	FormatPartial("12345")      // "1.234-5"
+//	FormatPartial("123456785")  // "12.345.678-5"
+//
+// A trailing dash keeps every digit in the number, so "12345678-" becomes
+// "12.345.678-" while the check digit is being typed.
+func FormatPartial(input string) string {
+	var chars [maxLength]byte
+	n := 0
+	for i := 0; i < len(input) && n < maxLength; i++ {
+		c := input[i]
+		switch {
+		case c == '0' && n == 0:
+			// Leading zero
+		case c >= '0' && c <= '9':
+			chars[n] = c
+			n++
+		case (c == 'k' || c == 'K') && n > 0:
+			chars[n] = 'K'
+			n++
+			// Nothing may follow the check digit
+			return formatPartial(chars[:n], false)
+		}
+	}
+	pendingDV := n < maxLength && strings.HasSuffix(strings.TrimRight(input, " "), "-")
+	return formatPartial(chars[:n], pendingDV)
+}
+
+// formatPartial groups the number and appends the dash and check digit.
+// If pendingDV is set every character belongs to the number.
+func formatPartial(chars []byte, pendingDV bool) string {
+	if len(chars) == 0 {
+		return ""
+	}
+	if pendingDV {
+		return string(appendGrouped(nil, chars, '.')) + "-"
+	}
+	if len(chars) == 1 {
+		return string(chars)
+	}
+	last := len(chars) - 1
+	b := appendGrouped(make([]byte, 0, 16), chars[:last], '.')
+	b = append(b, '-', chars[last])
+	return string(b)
+}
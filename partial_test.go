@@ -0,0 +1,38 @@
+package rut
+
+import "testing"
+
+func TestFormatPartial(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"", ""},
+		{"1", "1"},
+		{"12", "1-2"},
+		{"123", "12-3"},
+		{"1234", "123-4"},
+		{"12345", "1.234-5"},
+		{"123456785", "12.345.678-5"},
+		{"12.345.678-5", "12.345.678-5"},
+		{"1.234-5", "1.234-5"},
+		{"12345678-", "12.345.678-"},
+		{"12345678 - ", "12.345.678-"},
+		{"1009k", "1.009-K"},
+		{"1009K5", "1.009-K"},
+		{"k", ""},
+		{"1k2", "1-K"},
+		{"00123", "12-3"},
+		{"12a3b", "12-3"},
+		{"12345678901", "123.456.789-0"},
+		{"123456789-", "123.456.789-"},
+		{"60803000k", "60.803.000-K"},
+		{"1234567890-", "123.456.789-0"},
+	}
+
+	for _, tt := range tests {
+		if got := FormatPartial(tt.input); got != tt.expected {
+			t.Errorf("FormatPartial(%q) = %q; want %q", tt.input, got, tt.expected)
+		}
+	}
+}
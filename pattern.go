@@ -0,0 +1,28 @@
+package rut
+
+import (
+	"regexp"
+	"sync"
+)
+
+// pattern matches exactly the inputs accepted by Parse: 4 to 9 digits and
+// a check digit, with dots, dashes and spaces anywhere.
+const pattern = `^[.\- ]*(?:[0-9][.\- ]*){4,9}[0-9Kk][.\- ]*$`
+
+// Pattern returns a regular expression matching the inputs accepted by
+// Parse, for client side validation, OpenAPI schemas and API gateways. It
+// only checks the format and length, not the check digit, and uses a
+// syntax shared by RE2, ECMAScript and PCRE.
+func Pattern() string {
+	return pattern
+}
+
+var compiledPattern = sync.OnceValue(func() *regexp.Regexp {
+	return regexp.MustCompile(pattern)
+})
+
+// Regexp returns Pattern compiled. The result is shared and safe for
+// concurrent use.
+func Regexp() *regexp.Regexp {
+	return compiledPattern()
+}
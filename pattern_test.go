@@ -0,0 +1,48 @@
+package rut
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestRegexp(t *testing.T) {
+	inputs := []string{
+		"12.345.678-5",
+		"12345678-5",
+		"123456785",
+		"12 345 678-5",
+		"1.009-k",
+		"1234.5678-5",
+		" 12.345.678-5 ",
+		"-1009K-",
+		"1-9",
+		"1234",
+		"12345678901",
+		"12.34K.678-5",
+		"12.345.678_5",
+		"abc",
+		"",
+	}
+	for _, input := range inputs {
+		_, err := Parse(input)
+		if got, want := Regexp().MatchString(input), err == nil; got != want {
+			t.Errorf("Regexp().MatchString(%q) = %v; want %v", input, got, want)
+		}
+	}
+}
+
+func TestRegexp_MatchesParse(t *testing.T) {
+	const alphabet = "0123456789kK.- x"
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 20000; i++ {
+		b := make([]byte, rng.Intn(16))
+		for j := range b {
+			b[j] = alphabet[rng.Intn(len(alphabet))]
+		}
+		input := string(b)
+		_, err := Parse(input)
+		if got, want := Regexp().MatchString(input), err == nil; got != want {
+			t.Fatalf("Regexp().MatchString(%q) = %v; want %v", input, got, want)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package rut
+
+import "strconv"
+
+// FinalConsumer is the RUT 66.666.666-6 that the SII defines for the
+// receptor of a boleta when the buyer is anonymous, the consumidor final.
+var FinalConsumer = RUT{Number: 66_666_666, DV: '6'}
+
+// reserved are the placeholder RUTs defined by the SII.
+var reserved = []RUT{
+	{Number: 55_555_555, DV: '5'}, // Exports and foreign buyers without a RUT
+	FinalConsumer,
+}
+
+// IsFinalConsumer reports whether r is FinalConsumer.
+func (r RUT) IsFinalConsumer() bool {
+	return r.Number == FinalConsumer.Number
+}
+
+// OrFinalConsumer returns r, or FinalConsumer if r is the zero RUT, for
+// filling the receptor of a sale where the buyer gave no RUT:
+//
+//	boleta.Receptor = customer.RUT.OrFinalConsumer()
+func (r RUT) OrFinalConsumer() RUT {
+	if r.IsZero() {
+		return FinalConsumer
+	}
+	return r
+}
+
+// IsReserved reports whether r is a placeholder RUT defined by the SII,
+// 55.555.555-5 for exports and 66.666.666-6 for anonymous consumers. They
+// have valid check digits but do not identify anyone.
+func (r RUT) IsReserved() bool {
+	for _, v := range reserved {
+		if r.Number == v.Number {
+			return true
+		}
+	}
+	return false
+}
+
+// minSequenceDigits is the shortest run of consecutive digits, such as
+// 123456, that IsFictitious reports.
+const minSequenceDigits = 6
+
+// IsFictitious reports whether the RUT number looks made up: a trivial
+// number below 1.000, a repeated digit such as 11.111.111 or a sequence
+// such as 12.345.678 or 98.765.432. Such RUTs are usually typed to get past
+// a form and often have a valid check digit, so data quality jobs flag them
+// separately.
+func (r RUT) IsFictitious() bool {
+	if r.Number < 1000 {
+		return true
+	}
+
+	repeated, sequence := digitPatterns(r.Number)
+	return repeated || sequence
+}
+
+// digitPatterns reports whether the digits of n are all equal, and whether
+// they form an ascending or descending sequence of at least
+// minSequenceDigits digits.
+func digitPatterns(n int) (repeated, sequence bool) {
+	var buf [20]byte
+	digits := strconv.AppendInt(buf[:0], int64(n), 10)
+	repeated, up, down := true, true, true
+	for i := 1; i < len(digits); i++ {
+		d := int(digits[i]) - int(digits[i-1])
+		repeated = repeated && d == 0
+		up = up && d == 1
+		down = down && d == -1
+	}
+	return repeated, len(digits) >= minSequenceDigits && (up || down)
+}
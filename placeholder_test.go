@@ -0,0 +1,63 @@
+package rut
+
+import "testing"
+
+func TestRUT_IsReserved(t *testing.T) {
+	tests := []struct {
+		input string
+		want  bool
+	}{
+		{"66.666.666-6", true},
+		{"55.555.555-5", true},
+		{"11.111.111-1", false},
+		{"60.803.000-K", false},
+	}
+
+	for _, tt := range tests {
+		if got := MustParse(tt.input).IsReserved(); got != tt.want {
+			t.Errorf("IsReserved(%q) = %v; want %v", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestRUT_IsFictitious(t *testing.T) {
+	tests := []struct {
+		r    RUT
+		want bool
+	}{
+		{RUT{Number: 1, DV: '9'}, true},
+		{RUT{Number: 999, DV: CalculateDV(999)}, true},
+		{MustParse("11.111.111-1"), true},
+		{MustParse("9.999-K"), true},
+		{MustParse("12.345.678-5"), true},
+		{MustParse("123.456.789-6"), true},
+		{MustParse("98.765.432-5"), true},
+		{MustParse("1.234-3"), false},
+		{MustParse("12.345.679-3"), false},
+		{MustParse("60.803.000-K"), false},
+		{MustParse("7.654.321-6"), true},
+	}
+
+	for _, tt := range tests {
+		if got := tt.r.IsFictitious(); got != tt.want {
+			t.Errorf("%v.IsFictitious() = %v; want %v", tt.r, got, tt.want)
+		}
+	}
+}
+
+func TestFinalConsumer(t *testing.T) {
+	if !FinalConsumer.Validate() || FinalConsumer.String() != "66.666.666-6" {
+		t.Errorf("FinalConsumer = %v; want valid 66.666.666-6", FinalConsumer)
+	}
+	if !MustParse("66666666-6").IsFinalConsumer() || MustParse("55.555.555-5").IsFinalConsumer() {
+		t.Errorf("IsFinalConsumer() does not match only 66.666.666-6")
+	}
+
+	r := MustParse("12.345.678-5")
+	if got := r.OrFinalConsumer(); got != r {
+		t.Errorf("%v.OrFinalConsumer() = %v; want %v", r, got, r)
+	}
+	if got := (RUT{}).OrFinalConsumer(); got != FinalConsumer {
+		t.Errorf("RUT{}.OrFinalConsumer() = %v; want %v", got, FinalConsumer)
+	}
+}
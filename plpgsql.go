@@ -0,0 +1,74 @@
+package rut
+
+import (
+	"fmt"
+	"strings"
+)
+
+// plpgsqlTemplate defines validate_rut and normalize_rut. validate_rut
+// follows Validate, and normalize_rut follows Parse followed by Format,
+// returning NULL for values that are not valid RUTs. {{format}} is
+// replaced by the expression formatting num and dv in the chosen style.
+const plpgsqlTemplate = `CREATE OR REPLACE FUNCTION validate_rut(val text) RETURNS boolean
+LANGUAGE plpgsql IMMUTABLE STRICT PARALLEL SAFE AS $$
+DECLARE
+	s text := upper(translate(val, '. -', ''));
+	n int := length(s);
+	total int := 0;
+BEGIN
+	IF n < 5 OR n > 10 OR s !~ '^[0-9]+[0-9K]$' THEN
+		RETURN false;
+	END IF;
+	-- Multipliers 2 to 7 from the rightmost digit of the number
+	FOR i IN 1..n - 1 LOOP
+		total := total + substr(s, n - i, 1)::int * (2 + (i - 1) % 6);
+	END LOOP;
+	RETURN total > 0 AND right(s, 1) = substr('0K987654321', total % 11 + 1, 1);
+END
+$$;
+
+CREATE OR REPLACE FUNCTION normalize_rut(val text) RETURNS text
+LANGUAGE plpgsql IMMUTABLE STRICT PARALLEL SAFE AS $$
+DECLARE
+	s text := upper(translate(val, '. -', ''));
+	num text := ltrim(left(s, -1), '0');
+	dv text := right(s, 1);
+BEGIN
+	IF NOT validate_rut(s) THEN
+		RETURN NULL;
+	END IF;
+	RETURN {{format}};
+END
+$$;
+`
+
+// PLpgSQL returns the SQL creating two PostgreSQL functions that apply the
+// rules of this package, for ETL jobs running inside the database:
+//
+//	validate_rut(text) boolean  same result as Validate
+//	normalize_rut(text) text    the RUT formatted in style, or NULL if invalid
+//
+// Both are immutable, so they can be used in indexes, generated columns
+// and CHECK constraints. FormatPreserve is not supported.
+func PLpgSQL(style FormatStyle) (string, error) {
+	const grouped = `regexp_replace(num, '(\d)(?=(\d{3})+$)', '\1%s', 'g')`
+
+	var format string
+	switch style {
+	case FormatComplete:
+		format = fmt.Sprintf(grouped, ".") + " || '-' || dv"
+	case FormatSpaces:
+		format = fmt.Sprintf(grouped, " ") + " || '-' || dv"
+	case FormatEscaped:
+		format = "num || dv"
+	case FormatWithDash:
+		format = "num || '-' || dv"
+	case FormatNumberOnly:
+		format = "num"
+	case FormatNumberDots:
+		format = fmt.Sprintf(grouped, ".")
+	default:
+		return "", fmt.Errorf("rut: no PL/pgSQL function for style %d", style)
+	}
+	return strings.Replace(plpgsqlTemplate, "{{format}}", format, 1), nil
+}
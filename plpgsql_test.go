@@ -0,0 +1,40 @@
+package rut
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPLpgSQL(t *testing.T) {
+	tests := []struct {
+		style FormatStyle
+		want  string
+	}{
+		{FormatComplete, `RETURN regexp_replace(num, '(\d)(?=(\d{3})+$)', '\1.', 'g') || '-' || dv;`},
+		{FormatSpaces, `RETURN regexp_replace(num, '(\d)(?=(\d{3})+$)', '\1 ', 'g') || '-' || dv;`},
+		{FormatEscaped, `RETURN num || dv;`},
+		{FormatWithDash, `RETURN num || '-' || dv;`},
+		{FormatNumberOnly, `RETURN num;`},
+	}
+
+	for _, tt := range tests {
+		got, err := PLpgSQL(tt.style)
+		if err != nil {
+			t.Errorf("PLpgSQL(%d) error = %v", tt.style, err)
+			continue
+		}
+		for _, want := range []string{
+			"CREATE OR REPLACE FUNCTION validate_rut(val text) RETURNS boolean",
+			"CREATE OR REPLACE FUNCTION normalize_rut(val text) RETURNS text",
+			tt.want,
+		} {
+			if !strings.Contains(got, want) {
+				t.Errorf("PLpgSQL(%d) does not contain %s", tt.style, want)
+			}
+		}
+	}
+
+	if _, err := PLpgSQL(FormatPreserve); err == nil {
+		t.Errorf("PLpgSQL(FormatPreserve) error = nil; want an error")
+	}
+}
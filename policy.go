@@ -0,0 +1,140 @@
+package rut
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Policy errors
+var (
+	ErrOutOfRange     = errors.New("rut: number out of allowed range")
+	ErrKindNotAllowed = errors.New("rut: kind not allowed")
+	ErrDenied         = errors.New("rut: denied")
+	ErrFictitious     = errors.New("rut: fictitious RUT")
+	ErrReserved       = errors.New("rut: reserved RUT")
+)
+
+// Rule checks a RUT against one acceptance criterion, returning nil if it
+// passes. Rules are combined with All, Any and Not into the acceptance
+// policy of a service, which can then be shared by its HTTP, gRPC and
+// batch paths:
+//
+//	policy := rut.All(
+//		rut.ValidDV(),
+//		rut.KindIs(rut.KindPerson, rut.KindProvisional),
+//		rut.NotFictitious(),
+//		rut.NotInList(sanctions),
+//	)
+//	if err := policy(r); err != nil {
+//		...
+//	}
+type Rule func(RUT) error
+
+// All returns a rule that passes if every rule passes. Otherwise it
+// returns the failures joined with errors.Join.
+func All(rules ...Rule) Rule {
+	return func(r RUT) error {
+		var errs []error
+		for _, rule := range rules {
+			if err := rule(r); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		return errors.Join(errs...)
+	}
+}
+
+// Any returns a rule that passes if at least one rule passes. Otherwise it
+// returns the failures joined with errors.Join. Any with no rules always
+// fails.
+func Any(rules ...Rule) Rule {
+	return func(r RUT) error {
+		errs := make([]error, 0, len(rules))
+		for _, rule := range rules {
+			err := rule(r)
+			if err == nil {
+				return nil
+			}
+			errs = append(errs, err)
+		}
+		if len(errs) == 0 {
+			return ErrDenied
+		}
+		return errors.Join(errs...)
+	}
+}
+
+// Not returns a rule that passes if rule fails, and returns err otherwise.
+func Not(rule Rule, err error) Rule {
+	return func(r RUT) error {
+		if rule(r) == nil {
+			return err
+		}
+		return nil
+	}
+}
+
+// ValidDV returns a rule requiring a correct check digit.
+func ValidDV() Rule {
+	return func(r RUT) error {
+		if !r.Validate() {
+			return ErrInvalidDV
+		}
+		return nil
+	}
+}
+
+// NumberRange returns a rule requiring a number from min to max, inclusive.
+func NumberRange(min, max int) Rule {
+	return func(r RUT) error {
+		if r.Number < min || r.Number > max {
+			return ErrOutOfRange
+		}
+		return nil
+	}
+}
+
+// KindIs returns a rule requiring one of the given kinds, see RUT.Kind.
+func KindIs(kinds ...Kind) Rule {
+	return func(r RUT) error {
+		k := r.Kind()
+		for _, want := range kinds {
+			if k == want {
+				return nil
+			}
+		}
+		return fmt.Errorf("%w: %v", ErrKindNotAllowed, k)
+	}
+}
+
+// NotInList returns a rule requiring c to allow the RUT. The error wraps
+// ErrDenied and includes the reason given by c.
+func NotInList(c *ListChecker) Rule {
+	return func(r RUT) error {
+		if res := c.Check(r); !res.Allowed {
+			return fmt.Errorf("%w: %s", ErrDenied, res.Reason)
+		}
+		return nil
+	}
+}
+
+// NotFictitious returns a rule rejecting made up RUTs, see IsFictitious.
+func NotFictitious() Rule {
+	return func(r RUT) error {
+		if r.IsFictitious() {
+			return ErrFictitious
+		}
+		return nil
+	}
+}
+
+// NotReserved returns a rule rejecting the placeholder RUTs defined by the
+// SII, see IsReserved.
+func NotReserved() Rule {
+	return func(r RUT) error {
+		if r.IsReserved() {
+			return ErrReserved
+		}
+		return nil
+	}
+}
@@ -0,0 +1,142 @@
+package rut
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// PolicyConfig describes an acceptance policy in configuration files, so
+// operators can adjust it without a redeploy. Its JSON form is:
+//
+//	{
+//		"min": 1000000,
+//		"max": 99999999,
+//		"kind": ["persona", "empresa"],
+//		"deny_file": "/etc/app/deny.txt",
+//		"allow_file": "/etc/app/allow.txt",
+//		"reject_fictitious": true,
+//		"reject_reserved": true
+//	}
+//
+// Every field is optional, and kind also accepts a single string.
+//
+// The package has no YAML dependency, so there is no YAML loader. The
+// struct has yaml tags instead: decode YAML into a PolicyConfig yourself
+// and call Rule. A single kind string is understood by libraries that use
+// the func(any) error unmarshaler, such as gopkg.in/yaml.v2, and by
+// sigs.k8s.io/yaml, which goes through JSON.
+type PolicyConfig struct {
+	Min              int      `json:"min,omitempty" yaml:"min,omitempty"`
+	Max              int      `json:"max,omitempty" yaml:"max,omitempty"`
+	Kind             KindList `json:"kind,omitempty" yaml:"kind,omitempty"`
+	DenyFile         string   `json:"deny_file,omitempty" yaml:"deny_file,omitempty"`
+	AllowFile        string   `json:"allow_file,omitempty" yaml:"allow_file,omitempty"`
+	RejectFictitious bool     `json:"reject_fictitious,omitempty" yaml:"reject_fictitious,omitempty"`
+	RejectReserved   bool     `json:"reject_reserved,omitempty" yaml:"reject_reserved,omitempty"`
+}
+
+// kindNamesByConfig maps the kind names accepted in a PolicyConfig, in
+// Spanish and English, to kinds.
+var kindNamesByConfig = map[string]Kind{
+	"persona":     KindPerson,
+	"person":      KindPerson,
+	"empresa":     KindCompany,
+	"company":     KindCompany,
+	"provisorio":  KindProvisional,
+	"provisional": KindProvisional,
+}
+
+// KindList is a list of kind names, such as "persona" or "company". In
+// configuration files it can also be written as a single string.
+type KindList []string
+
+// UnmarshalJSON accepts a string or a list of strings.
+func (k *KindList) UnmarshalJSON(data []byte) error {
+	var s string
+	if json.Unmarshal(data, &s) == nil {
+		*k = KindList{s}
+		return nil
+	}
+	return json.Unmarshal(data, (*[]string)(k))
+}
+
+// UnmarshalYAML accepts a string or a list of strings.
+func (k *KindList) UnmarshalYAML(unmarshal func(any) error) error {
+	var s string
+	if unmarshal(&s) == nil {
+		*k = KindList{s}
+		return nil
+	}
+	return unmarshal((*[]string)(k))
+}
+
+// LoadPolicy reads a PolicyConfig in JSON from r and returns its rule.
+// Unknown fields are rejected, so typos do not silently relax the policy.
+func LoadPolicy(r io.Reader) (Rule, error) {
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	var c PolicyConfig
+	if err := dec.Decode(&c); err != nil {
+		return nil, fmt.Errorf("rut: policy: %w", err)
+	}
+	return c.Rule()
+}
+
+// ParsePolicy is like LoadPolicy for a configuration held in memory.
+func ParsePolicy(data []byte) (Rule, error) {
+	return LoadPolicy(bytes.NewReader(data))
+}
+
+// Rule builds the rule described by c. Every policy requires a valid check
+// digit. The deny and allow files are read once, into a new ListChecker.
+func (c PolicyConfig) Rule() (Rule, error) {
+	rules := []Rule{ValidDV()}
+
+	if c.Min != 0 || c.Max != 0 {
+		max := c.Max
+		if max == 0 {
+			max = MaxProvisionalNumber
+		}
+		if c.Min > max {
+			return nil, fmt.Errorf("rut: policy: min %d above max %d", c.Min, max)
+		}
+		rules = append(rules, NumberRange(c.Min, max))
+	}
+
+	if len(c.Kind) > 0 {
+		kinds := make([]Kind, 0, len(c.Kind))
+		for _, name := range c.Kind {
+			k, ok := kindNamesByConfig[name]
+			if !ok {
+				return nil, fmt.Errorf("rut: policy: unknown kind %q", name)
+			}
+			kinds = append(kinds, k)
+		}
+		rules = append(rules, KindIs(kinds...))
+	}
+
+	if c.DenyFile != "" || c.AllowFile != "" {
+		lists := NewListChecker()
+		if c.DenyFile != "" {
+			if err := lists.LoadDenyFile(c.DenyFile); err != nil {
+				return nil, fmt.Errorf("rut: policy: %w", err)
+			}
+		}
+		if c.AllowFile != "" {
+			if err := lists.LoadAllowFile(c.AllowFile); err != nil {
+				return nil, fmt.Errorf("rut: policy: %w", err)
+			}
+		}
+		rules = append(rules, NotInList(lists))
+	}
+
+	if c.RejectFictitious {
+		rules = append(rules, NotFictitious())
+	}
+	if c.RejectReserved {
+		rules = append(rules, NotReserved())
+	}
+	return All(rules...), nil
+}
@@ -0,0 +1,74 @@
+package rut
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParsePolicy(t *testing.T) {
+	deny := filepath.Join(t.TempDir(), "deny.txt")
+	if err := os.WriteFile(deny, []byte("15.234.567-4, fraud\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	config := `{
+		"min": 1000000,
+		"kind": "persona",
+		"deny_file": "` + filepath.ToSlash(deny) + `",
+		"reject_fictitious": true
+	}`
+	policy, err := ParsePolicy([]byte(config))
+	if err != nil {
+		t.Fatalf("ParsePolicy() error = %v", err)
+	}
+
+	tests := []struct {
+		input   string
+		wantErr error
+	}{
+		{"7.654.322-4", nil},
+		{"7.654.322-5", ErrInvalidDV},
+		{"1.009-K", ErrOutOfRange},
+		{"60.803.000-K", ErrKindNotAllowed},
+		{"15.234.567-4", ErrDenied},
+		{"11.111.111-1", ErrFictitious},
+	}
+	for _, tt := range tests {
+		err := policy(MustParse(tt.input))
+		if (tt.wantErr == nil) != (err == nil) || !errors.Is(err, tt.wantErr) {
+			t.Errorf("policy(%q) = %v; want %v", tt.input, err, tt.wantErr)
+		}
+	}
+}
+
+func TestParsePolicy_Invalid(t *testing.T) {
+	tests := []string{
+		`{"kind": "alien"}`,
+		`{"min": 10, "max": 5}`,
+		`{"deny": "typo.txt"}`,
+		`{"deny_file": "does-not-exist.txt"}`,
+		`{"kind": 1}`,
+	}
+
+	for _, config := range tests {
+		if _, err := ParsePolicy([]byte(config)); err == nil {
+			t.Errorf("ParsePolicy(%s) error = nil; want an error", config)
+		}
+	}
+}
+
+func TestPolicyConfig_KindList(t *testing.T) {
+	c := PolicyConfig{Kind: KindList{"empresa", "provisional"}}
+	policy, err := c.Rule()
+	if err != nil {
+		t.Fatalf("Rule() error = %v", err)
+	}
+	if err := policy(MustParse("60.803.000-K")); err != nil {
+		t.Errorf("policy(company) = %v; want nil", err)
+	}
+	if err := policy(MustParse("7.654.322-4")); !errors.Is(err, ErrKindNotAllowed) {
+		t.Errorf("policy(person) = %v; want %v", err, ErrKindNotAllowed)
+	}
+}
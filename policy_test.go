@@ -0,0 +1,74 @@
+package rut
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestRules(t *testing.T) {
+	deny := NewListChecker()
+	if err := deny.SetDenyList(strings.NewReader("7.654.321-6, fraud\n")); err != nil {
+		t.Fatal(err)
+	}
+
+	policy := All(
+		ValidDV(),
+		KindIs(KindPerson, KindProvisional),
+		NotFictitious(),
+		NotInList(deny),
+	)
+
+	tests := []struct {
+		input   string
+		wantErr []error
+	}{
+		{"15.234.567-4", nil},
+		{"15.234.567-1", []error{ErrInvalidDV}},
+		{"60.803.000-K", []error{ErrKindNotAllowed}},
+		{"11.111.111-1", []error{ErrFictitious}},
+		{"7.654.321-6", []error{ErrFictitious, ErrDenied}},
+		{"66.666.666-6", []error{ErrKindNotAllowed, ErrFictitious}},
+	}
+
+	for _, tt := range tests {
+		err := policy(MustParse(tt.input))
+		if (err == nil) != (tt.wantErr == nil) {
+			t.Errorf("policy(%q) = %v; want %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		for _, want := range tt.wantErr {
+			if !errors.Is(err, want) {
+				t.Errorf("policy(%q) = %v; want it to include %v", tt.input, err, want)
+			}
+		}
+	}
+}
+
+func TestRules_Combinators(t *testing.T) {
+	company := MustParse("60.803.000-K")
+	person := MustParse("15.234.567-4")
+
+	anyRule := Any(KindIs(KindCompany), NumberRange(1, 20_000_000))
+	if err := anyRule(company); err != nil {
+		t.Errorf("Any()(company) = %v; want nil", err)
+	}
+	if err := anyRule(MustParse("100.123.456-0")); !errors.Is(err, ErrKindNotAllowed) || !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("Any()(provisional) = %v; want both failures", err)
+	}
+	if err := Any()(person); err == nil {
+		t.Errorf("Any()() = nil; want an error")
+	}
+
+	notCompany := Not(KindIs(KindCompany), ErrKindNotAllowed)
+	if err := notCompany(person); err != nil {
+		t.Errorf("Not()(person) = %v; want nil", err)
+	}
+	if err := notCompany(company); !errors.Is(err, ErrKindNotAllowed) {
+		t.Errorf("Not()(company) = %v; want %v", err, ErrKindNotAllowed)
+	}
+
+	if err := NotReserved()(FinalConsumer); !errors.Is(err, ErrReserved) {
+		t.Errorf("NotReserved()(FinalConsumer) = %v; want %v", err, ErrReserved)
+	}
+}
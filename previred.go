@@ -0,0 +1,46 @@
+package rut
+
+import (
+	"strconv"
+	"strings"
+)
+
+// PreviredNumberWidth is the width of the RUT number fields of the
+// Previred payroll file, the 105 field format used to declare social
+// security contributions. Numbers are zero padded and the check digit goes
+// in the next field.
+const PreviredNumberWidth = 11
+
+// PreviredFields returns r as the number and check digit fields of a
+// Previred file, as in "00012345678" and "5". It returns ErrInvalidDV if
+// the check digit does not match, since Previred rejects the whole file
+// for a single wrong RUT, and ErrFieldTooLong if the number does not fit.
+func PreviredFields(r RUT) (number, dv string, err error) {
+	if !r.Validate() {
+		return "", "", ErrInvalidDV
+	}
+	number = strconv.Itoa(r.Number)
+	if len(number) > PreviredNumberWidth {
+		return "", "", ErrFieldTooLong
+	}
+	return strings.Repeat("0", PreviredNumberWidth-len(number)) + number, string(r.DV), nil
+}
+
+// ParsePrevired reads the number and check digit fields of a Previred
+// file, with or without zero padding, and verifies the check digit.
+func ParsePrevired(number, dv string) (RUT, error) {
+	number = strings.TrimSpace(number)
+	if trimmed := strings.TrimLeft(number, "0"); trimmed != "" || number == "" {
+		number = trimmed
+	} else {
+		number = "0"
+	}
+	r, err := FromParts(number, dv)
+	if err != nil {
+		return RUT{}, err
+	}
+	if !r.Validate() {
+		return RUT{}, ErrInvalidDV
+	}
+	return r, nil
+}
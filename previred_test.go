@@ -0,0 +1,57 @@
+package rut
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestPreviredFields(t *testing.T) {
+	tests := []struct {
+		r          RUT
+		number, dv string
+		wantErr    error
+	}{
+		{RUT{Number: 12_345_678, DV: '5'}, "00012345678", "5", nil},
+		{RUT{Number: 1009, DV: 'K'}, "00000001009", "K", nil},
+		{RUT{Number: 100_123_456, DV: '7'}, "00100123456", "7", nil},
+		{RUT{Number: 12_345_678, DV: '0'}, "", "", ErrInvalidDV},
+		{RUT{}, "", "", ErrInvalidDV},
+	}
+
+	for _, tt := range tests {
+		number, dv, err := PreviredFields(tt.r)
+		if number != tt.number || dv != tt.dv || !errors.Is(err, tt.wantErr) {
+			t.Errorf("PreviredFields(%v) = %q, %q, %v; want %q, %q, %v", tt.r, number, dv, err, tt.number, tt.dv, tt.wantErr)
+		}
+	}
+
+	if strconv.IntSize == 64 {
+		r := RUT{Number: maxNumber, DV: CalculateDV(maxNumber)}
+		if _, _, err := PreviredFields(r); !errors.Is(err, ErrFieldTooLong) {
+			t.Errorf("PreviredFields(%v) error = %v; want %v", r, err, ErrFieldTooLong)
+		}
+	}
+}
+
+func TestParsePrevired(t *testing.T) {
+	tests := []struct {
+		number, dv string
+		want       RUT
+		wantErr    error
+	}{
+		{"00012345678", "5", RUT{Number: 12_345_678, DV: '5'}, nil},
+		{"12345678", "5", RUT{Number: 12_345_678, DV: '5'}, nil},
+		{" 00000001009", "k", RUT{Number: 1009, DV: 'K'}, nil},
+		{"00012345678", "0", RUT{}, ErrInvalidDV},
+		{"00000000000", "0", RUT{}, ErrTooShort},
+		{"", "", RUT{}, ErrEmptyRUT},
+	}
+
+	for _, tt := range tests {
+		got, err := ParsePrevired(tt.number, tt.dv)
+		if got != tt.want || !errors.Is(err, tt.wantErr) {
+			t.Errorf("ParsePrevired(%q, %q) = %v, %v; want %v, %v", tt.number, tt.dv, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package rut
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// ProblemType is the type URI of the problems built by NewProblem, pointing
+// at the documentation of the errors. Services may replace it with their
+// own URI during initialization.
+var ProblemType = "https://github.com/jestays/rut-go#errors"
+
+// Problem is an RFC 7807 problem details object describing invalid RUTs,
+// for consistent application/problem+json error bodies across APIs.
+type Problem struct {
+	Type          string         `json:"type"`
+	Title         string         `json:"title"`
+	Status        int            `json:"status"`
+	Detail        string         `json:"detail,omitempty"`
+	Instance      string         `json:"instance,omitempty"`
+	InvalidParams []InvalidParam `json:"invalid-params,omitempty"`
+}
+
+// InvalidParam is an entry of the invalid-params extension of a Problem.
+type InvalidParam struct {
+	Name   string    `json:"name"`
+	Reason string    `json:"reason"`
+	Code   ErrorCode `json:"code,omitempty"`
+}
+
+// NewProblem builds a Problem with status 400 from a validation error,
+// with messages localized in lang as by Localize. Every *FieldError in err,
+// including those joined by ValidateStruct, becomes an invalid parameter;
+// wrap other errors in a FieldError to name the offending parameter:
+//
+//	if _, err := rut.ParseStrict(q); err != nil {
+//		p := rut.NewProblem(&rut.FieldError{Field: "rut", Err: err}, "es-CL")
+//	}
+func NewProblem(err error, lang string) *Problem {
+	p := &Problem{
+		Type:   ProblemType,
+		Title:  "Invalid RUT",
+		Status: http.StatusBadRequest,
+	}
+
+	fields := fieldErrors(err, nil)
+	reasons := make([]string, 0, len(fields))
+	for _, fe := range fields {
+		reason := Localize(fe.Err, lang)
+		p.InvalidParams = append(p.InvalidParams, InvalidParam{
+			Name:   fe.Field,
+			Reason: reason,
+			Code:   CodeOf(fe.Err),
+		})
+		reasons = append(reasons, fe.Field+": "+reason)
+	}
+	if len(fields) == 0 {
+		p.Detail = Localize(err, lang)
+	} else {
+		p.Detail = strings.Join(reasons, "; ")
+	}
+	return p
+}
+
+// fieldErrors appends the FieldErrors in the tree of err to dst.
+func fieldErrors(err error, dst []*FieldError) []*FieldError {
+	var fe *FieldError
+	switch e := err.(type) {
+	case nil:
+	case interface{ Unwrap() []error }:
+		for _, err := range e.Unwrap() {
+			dst = fieldErrors(err, dst)
+		}
+	default:
+		if errors.As(err, &fe) {
+			dst = append(dst, fe)
+		}
+	}
+	return dst
+}
+
+// WriteProblem writes the Problem for err as an application/problem+json
+// response, localized according to the Accept-Language header of r. The
+// instance is the path of the request.
+func WriteProblem(w http.ResponseWriter, r *http.Request, err error) {
+	p := NewProblem(err, acceptLanguage(r.Header.Get("Accept-Language")))
+	p.Instance = r.URL.Path
+
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(p.Status)
+	json.NewEncoder(w).Encode(p)
+}
+
+// acceptLanguage returns the preferred language of an Accept-Language
+// header, which lists it first in practice, or "en".
+func acceptLanguage(header string) string {
+	tag, _, _ := strings.Cut(header, ",")
+	tag, _, _ = strings.Cut(tag, ";")
+	tag = strings.TrimSpace(tag)
+	if tag == "" || tag == "*" {
+		return "en"
+	}
+	return tag
+}
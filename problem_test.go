@@ -0,0 +1,98 @@
+package rut
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestNewProblem(t *testing.T) {
+	_, dvErr := ParseStrict("12.345.678-0")
+	err := errors.Join(
+		&FieldError{Field: "Emisor", Err: dvErr},
+		&FieldError{Field: "Receptor", Err: ErrRequired},
+	)
+
+	got := NewProblem(err, "es-CL")
+	want := &Problem{
+		Type:   ProblemType,
+		Title:  "Invalid RUT",
+		Status: http.StatusBadRequest,
+		Detail: "Emisor: El dígito verificador no corresponde; Receptor: El RUT es obligatorio",
+		InvalidParams: []InvalidParam{
+			{Name: "Emisor", Reason: "El dígito verificador no corresponde", Code: CodeInvalidDV},
+			{Name: "Receptor", Reason: "El RUT es obligatorio", Code: CodeRequired},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NewProblem() = %+v; want %+v", got, want)
+	}
+}
+
+func TestNewProblem_ValidateStruct(t *testing.T) {
+	v := struct {
+		Emisor RUT `rut:"required"`
+	}{}
+	p := NewProblem(ValidateStruct(&v), "en")
+	if len(p.InvalidParams) != 1 || p.InvalidParams[0].Name != "Emisor" || p.InvalidParams[0].Code != CodeRequired {
+		t.Errorf("NewProblem() = %+v", p)
+	}
+}
+
+func TestNewProblem_Bare(t *testing.T) {
+	_, err := Parse("")
+	p := NewProblem(err, "en")
+	if p.Detail != "Enter a RUT" || p.InvalidParams != nil {
+		t.Errorf("NewProblem() = %+v; want the detail only", p)
+	}
+}
+
+func TestWriteProblem(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/contribuyentes?rut=x", nil)
+	req.Header.Set("Accept-Language", "es-CL,es;q=0.9,en;q=0.8")
+	rec := httptest.NewRecorder()
+
+	_, err := ParseStrict("12.345.678-0")
+	WriteProblem(rec, req, &FieldError{Field: "rut", Err: err})
+
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("status = %d; want %d", rec.Code, http.StatusBadRequest)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/problem+json" {
+		t.Errorf("Content-Type = %q; want application/problem+json", ct)
+	}
+
+	var body map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	if body["instance"] != "/contribuyentes" || body["title"] != "Invalid RUT" {
+		t.Errorf("body = %v", body)
+	}
+	params, _ := body["invalid-params"].([]any)
+	if len(params) != 1 || params[0].(map[string]any)["reason"] != "El dígito verificador no corresponde" {
+		t.Errorf("invalid-params = %v", body["invalid-params"])
+	}
+}
+
+func TestAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		header string
+		want   string
+	}{
+		{"es-CL,es;q=0.9", "es-CL"},
+		{"en;q=0.8", "en"},
+		{" es ", "es"},
+		{"*", "en"},
+		{"", "en"},
+	}
+
+	for _, tt := range tests {
+		if got := acceptLanguage(tt.header); got != tt.want {
+			t.Errorf("acceptLanguage(%q) = %q; want %q", tt.header, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package rut
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ProtovalidateRules returns field options for a string field holding a
+// RUT, in the syntax of buf protovalidate. A pattern rule checks the format
+// like Parse and a CEL rule checks the check digit, so proto constraints
+// match the behavior of this package:
+//
+//	string emisor = 1 [
+//		<rules>
+//	];
+//
+// The CEL expression uses the strings extension bundled with protovalidate.
+// Empty strings fail the pattern; add
+// (buf.validate.field).ignore = IGNORE_IF_ZERO_VALUE for optional fields.
+func ProtovalidateRules() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "(buf.validate.field).string.pattern = %s,\n", strconv.Quote(Pattern()))
+	b.WriteString("(buf.validate.field).cel = {\n")
+	b.WriteString("  id: \"rut.check_digit\"\n")
+	b.WriteString("  message: \"invalid RUT check digit\"\n")
+	fmt.Fprintf(&b, "  expression: %s\n", strconv.Quote(checkDigitCEL()))
+	b.WriteString("}")
+	return b.String()
+}
+
+// PGVRules is like ProtovalidateRules for protoc-gen-validate, which only
+// supports the pattern rule, so the check digit must still be verified in
+// code.
+func PGVRules() string {
+	return fmt.Sprintf("(validate.rules).string.pattern = %s", strconv.Quote(Pattern()))
+}
+
+// checkDigitCEL returns a CEL expression verifying the check digit of
+// this. It is true for values not matching Pattern, which the pattern rule
+// reports instead. CEL has no loops, so the weighted sum is unrolled for
+// the 9 digits a number can have, using the same lookup as dvFromSum.
+func checkDigitCEL() string {
+	const digits = "this.replace('.', '').replace('-', '').replace(' ', '')"
+	number := "int(" + digits + ".substring(0, size(" + digits + ") - 1))"
+
+	var sum strings.Builder
+	pow := 1
+	for i := 0; i < maxLength-1; i++ {
+		if i > 0 {
+			sum.WriteString(" + ")
+		}
+		fmt.Fprintf(&sum, "%s / %d %% 10 * %d", number, pow, multipliers[i%6])
+		pow *= 10
+	}
+
+	return fmt.Sprintf("!this.matches('%s') || %s.substring(size(%s) - 1).upperAscii() == '0K987654321'.charAt((%s) %% 11)",
+		strings.ReplaceAll(Pattern(), `\`, `\\`), digits, digits, sum.String())
+}
@@ -0,0 +1,43 @@
+package rut
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestProtovalidateRules(t *testing.T) {
+	rules := ProtovalidateRules()
+	for _, want := range []string{
+		"(buf.validate.field).string.pattern = " + strconv.Quote(Pattern()) + ",",
+		`id: "rut.check_digit"`,
+		"expression: " + strconv.Quote(checkDigitCEL()),
+	} {
+		if !strings.Contains(rules, want) {
+			t.Errorf("ProtovalidateRules() does not contain %s:\n%s", want, rules)
+		}
+	}
+}
+
+func TestCheckDigitCEL(t *testing.T) {
+	expr := checkDigitCEL()
+	// The weights of the 9 digits, from the right, as in CalculateDV
+	for i, want := range []string{"/ 1 % 10 * 2", "/ 10 % 10 * 3", "/ 100000 % 10 * 7", "/ 1000000 % 10 * 2", "/ 100000000 % 10 * 4"} {
+		if !strings.Contains(expr, want) {
+			t.Errorf("term %d: checkDigitCEL() does not contain %q", i, want)
+		}
+	}
+	if strings.Contains(expr, "/ 1000000000 ") {
+		t.Errorf("checkDigitCEL() has a term for a 10th digit")
+	}
+	if !strings.HasPrefix(expr, `!this.matches('^[.\\- ]*`) {
+		t.Errorf("checkDigitCEL() does not escape the pattern: %s", expr[:40])
+	}
+}
+
+func TestPGVRules(t *testing.T) {
+	want := "(validate.rules).string.pattern = " + strconv.Quote(Pattern())
+	if got := PGVRules(); got != want {
+		t.Errorf("PGVRules() = %s; want %s", got, want)
+	}
+}
@@ -0,0 +1,86 @@
+package rut
+
+// IssueCode identifies a data quality problem found by an audit.
+type IssueCode string
+
+// Issues reported by AuditReport.Issues.
+const (
+	IssueNoValues     IssueCode = "no_values"     // Every value is empty
+	IssueInvalid      IssueCode = "invalid"       // Values that are not a valid RUT
+	IssueMixedFormats IssueCode = "mixed_formats" // Valid values not written in the most common style
+	IssueDuplicates   IssueCode = "duplicates"    // Extra occurrences of a RUT
+)
+
+// QualityIssue is a data quality problem and the number of values it
+// affects.
+type QualityIssue struct {
+	Code  IssueCode
+	Count int
+}
+
+// AuditValues reports on a column of values already in memory, such as a
+// CSV column, like AuditColumn does for a database column.
+func AuditValues(values []string) *AuditReport {
+	a := &AuditReport{
+		Duplicates: make(map[RUT]int),
+		Styles:     make(map[FormatStyle]int),
+	}
+	seen := NewSparseSet()
+	for _, v := range values {
+		a.add(v, seen)
+	}
+	return a
+}
+
+// Score rates the column from 0 to 100 for data catalogs that track
+// quality over time. The share of valid values among the non-empty ones
+// accounts for 60 points, the share of valid values written in the most
+// common style for 20, and the share of valid values that are not repeated
+// for 20. Empty values do not count against the score; a column without
+// values scores 0.
+func (a *AuditReport) Score() int {
+	if a.Valid == 0 {
+		return 0
+	}
+	score := 60*float64(a.Valid)/float64(a.Rows-a.Empty) +
+		20*float64(a.dominantStyle())/float64(a.Valid) +
+		20*float64(a.Valid-a.duplicates())/float64(a.Valid)
+	return int(score + 0.5)
+}
+
+// Issues lists the problems lowering the score, in the order IssueNoValues,
+// IssueInvalid, IssueMixedFormats and IssueDuplicates.
+func (a *AuditReport) Issues() []QualityIssue {
+	if a.Rows == a.Empty {
+		return []QualityIssue{{IssueNoValues, a.Rows}}
+	}
+	var issues []QualityIssue
+	if a.Invalid > 0 {
+		issues = append(issues, QualityIssue{IssueInvalid, a.Invalid})
+	}
+	if n := a.Valid - a.dominantStyle(); n > 0 {
+		issues = append(issues, QualityIssue{IssueMixedFormats, n})
+	}
+	if n := a.duplicates(); n > 0 {
+		issues = append(issues, QualityIssue{IssueDuplicates, n})
+	}
+	return issues
+}
+
+// dominantStyle returns the number of valid values written in the most
+// common style.
+func (a *AuditReport) dominantStyle() int {
+	most := a.UnknownStyle
+	for _, n := range a.Styles {
+		most = max(most, n)
+	}
+	return most
+}
+
+func (a *AuditReport) duplicates() int {
+	n := 0
+	for _, extra := range a.Duplicates {
+		n += extra
+	}
+	return n
+}
@@ -0,0 +1,47 @@
+package rut
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAuditValues(t *testing.T) {
+	a := AuditValues([]string{"12.345.678-5", "12345678-5", "", "1.009-K", "abc"})
+	want := &AuditReport{
+		Rows:           5,
+		Empty:          1,
+		Valid:          3,
+		Invalid:        1,
+		InvalidSamples: []string{"abc"},
+		Duplicates:     map[RUT]int{{Number: 12_345_678, DV: '5'}: 1},
+		Styles:         map[FormatStyle]int{FormatComplete: 2, FormatWithDash: 1},
+	}
+	if !reflect.DeepEqual(a, want) {
+		t.Errorf("AuditValues() = %+v; want %+v", a, want)
+	}
+}
+
+func TestAuditReport_Score(t *testing.T) {
+	tests := []struct {
+		values []string
+		score  int
+		issues []QualityIssue
+	}{
+		{[]string{"12.345.678-5", "1.009-K", ""}, 100, nil},
+		{[]string{"12.345.678-5", "1.009-K", "12.345.678-0", "abc"}, 70, []QualityIssue{{IssueInvalid, 2}}},
+		{[]string{"12.345.678-5", "1.009-K", "1009-K", "1.009-k"}, 85, []QualityIssue{{IssueMixedFormats, 1}, {IssueDuplicates, 2}}},
+		{[]string{"", " "}, 0, []QualityIssue{{IssueNoValues, 2}}},
+		{nil, 0, []QualityIssue{{IssueNoValues, 0}}},
+		{[]string{"abc"}, 0, []QualityIssue{{IssueInvalid, 1}}},
+	}
+
+	for _, tt := range tests {
+		a := AuditValues(tt.values)
+		if got := a.Score(); got != tt.score {
+			t.Errorf("AuditValues(%q).Score() = %d; want %d", tt.values, got, tt.score)
+		}
+		if got := a.Issues(); !reflect.DeepEqual(got, tt.issues) {
+			t.Errorf("AuditValues(%q).Issues() = %v; want %v", tt.values, got, tt.issues)
+		}
+	}
+}
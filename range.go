@@ -0,0 +1,23 @@
+//go:build go1.23
+
+package rut
+
+import "iter"
+
+// Range returns an iterator over the RUTs numbered from to to, inclusive,
+// with their check digits, for exhaustive tests and synthetic datasets:
+//
+//	for r := range rut.Range(1_000_000, 1_000_100) {
+//		...
+//	}
+//
+// Numbers below 1 are skipped. Range requires Go 1.23.
+func Range(from, to int) iter.Seq[RUT] {
+	return func(yield func(RUT) bool) {
+		for n := max(from, 1); n <= to; n++ {
+			if !yield(RUT{Number: n, DV: CalculateDV(n)}) || n == to {
+				return // n == to: n++ would overflow if to is math.MaxInt
+			}
+		}
+	}
+}
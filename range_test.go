@@ -0,0 +1,44 @@
+//go:build go1.23
+
+package rut
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestRange(t *testing.T) {
+	tests := []struct {
+		from, to int
+		want     []RUT
+	}{
+		{1008, 1010, []RUT{MustParse("1008-1"), MustParse("1.009-K"), MustParse("1010-3")}},
+		{-1, 1, []RUT{{Number: 1, DV: '9'}}},
+		{10, 9, nil},
+		{math.MaxInt - 1, math.MaxInt, []RUT{
+			{Number: math.MaxInt - 1, DV: CalculateDV(math.MaxInt - 1)},
+			{Number: math.MaxInt, DV: CalculateDV(math.MaxInt)},
+		}},
+		{math.MaxInt, math.MaxInt, []RUT{{Number: math.MaxInt, DV: CalculateDV(math.MaxInt)}}},
+		{math.MinInt, 0, nil},
+	}
+
+	for _, tt := range tests {
+		got := slices.Collect(Range(tt.from, tt.to))
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("Range(%d, %d) = %v; want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+
+	// Stops when the loop breaks
+	n := 0
+	for range Range(1, 1_000_000_000) {
+		if n++; n == 3 {
+			break
+		}
+	}
+	if n != 3 {
+		t.Errorf("Range() yielded %d RUTs after break; want 3", n)
+	}
+}
@@ -0,0 +1,125 @@
+package rut
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ErrUnrepairable is returned by Repair when no known transformation
+// yields a valid RUT.
+var ErrUnrepairable = errors.New("rut: unable to repair value")
+
+// RepairAction is a set of transformations applied by Repair.
+type RepairAction int
+
+const (
+	// RepairScientific expanded a value in scientific notation ("1.2345679E7")
+	RepairScientific RepairAction = 1 << iota
+	// RepairDecimalSuffix removed a zero decimal suffix ("12345678.0")
+	RepairDecimalSuffix
+	// RepairMissingDV treated the value as a number and appended its check digit
+	RepairMissingDV
+)
+
+// RepairNone means the value was already a valid RUT.
+const RepairNone RepairAction = 0
+
+var repairNames = []struct {
+	action RepairAction
+	name   string
+}{
+	{RepairScientific, "scientific notation"},
+	{RepairDecimalSuffix, "decimal suffix"},
+	{RepairMissingDV, "missing check digit"},
+}
+
+// String returns a comma separated description of the applied actions.
+func (a RepairAction) String() string {
+	if a == RepairNone {
+		return "none"
+	}
+	var names []string
+	for _, n := range repairNames {
+		if a&n.action != 0 {
+			names = append(names, n.name)
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+// Repair attempts to recover a valid RUT from a value damaged by a
+// spreadsheet, reporting which transformations were applied.
+//
+// It handles scientific notation ("1.2345679E7"), zero decimal suffixes
+// ("12345678.0") and numbers stored without their check digit. Values whose
+// leading zeros were stripped need no repair, since zeros do not change the
+// RUT number.
+//
+// A value that is already valid is returned with RepairNone. Any other run
+// of digits without a dash is assumed to lack its check digit, so callers
+// should review results flagged with RepairMissingDV.
+func Repair(s string) (RUT, RepairAction, error) {
+	s = strings.TrimSpace(s)
+	if r, err := Parse(s); err == nil && r.Validate() {
+		return r, RepairNone, nil
+	}
+
+	var action RepairAction
+	if v, ok := expandScientific(s); ok {
+		s = v
+		action |= RepairScientific
+	} else if v, ok := trimDecimalSuffix(s); ok {
+		s = v
+		action |= RepairDecimalSuffix
+	}
+
+	if action != RepairNone {
+		if r, err := Parse(s); err == nil && r.Validate() {
+			return r, action, nil
+		}
+	}
+
+	// Treat the value as a number without check digit
+	digits := strings.ReplaceAll(s, ".", "")
+	num, err := strconv.Atoi(digits)
+	if err != nil || num <= 0 || digits[0] == '+' {
+		return RUT{}, action, ErrUnrepairable
+	}
+	r := RUT{Number: num, DV: CalculateDV(num)}
+	return r, action | RepairMissingDV, nil
+}
+
+// expandScientific converts an integral value written in scientific
+// notation, with either '.' or ',' as decimal mark, to plain digits.
+func expandScientific(s string) (string, bool) {
+	if !strings.ContainsAny(s, "eE") {
+		return "", false
+	}
+	f, err := strconv.ParseFloat(strings.Replace(s, ",", ".", 1), 64)
+	if err != nil || f <= 0 || f >= 1e10 || f != math.Trunc(f) {
+		return "", false
+	}
+	return strconv.FormatInt(int64(f), 10), true
+}
+
+// trimDecimalSuffix removes a decimal part made only of zeros, such as the
+// ".0" added when a number column is exported as text. A three digit suffix
+// is left alone since it is indistinguishable from a thousands group.
+func trimDecimalSuffix(s string) (string, bool) {
+	i := strings.LastIndexAny(s, ".,")
+	if i <= 0 {
+		return "", false
+	}
+	frac := s[i+1:]
+	if frac == "" || len(frac) == 3 || strings.Trim(frac, "0") != "" {
+		return "", false
+	}
+	for j := 0; j < i; j++ {
+		if s[j] < '0' || s[j] > '9' {
+			return "", false
+		}
+	}
+	return s[:i], true
+}
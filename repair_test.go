@@ -0,0 +1,63 @@
+package rut
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRepair(t *testing.T) {
+	tests := []struct {
+		input      string
+		want       string
+		wantAction RepairAction
+		wantErr    error
+	}{
+		{"12.345.678-5", "12.345.678-5", RepairNone, nil},
+		{"123456785", "12.345.678-5", RepairNone, nil},
+		{"1.23456785E8", "12.345.678-5", RepairScientific, nil},
+		{"1,23456785E+08", "12.345.678-5", RepairScientific, nil},
+		{"123456785.0", "12.345.678-5", RepairDecimalSuffix, nil},
+		{"12345678", "12.345.678-5", RepairMissingDV, nil},
+		{"12.345.678", "12.345.678-5", RepairMissingDV, nil},
+		{"1.2345678E7", "12.345.678-5", RepairScientific | RepairMissingDV, nil},
+		{"12345678.00", "12.345.678-5", RepairDecimalSuffix | RepairMissingDV, nil},
+		{"12.345.678-0", "", RepairNone, ErrUnrepairable},
+		{"abc", "", RepairNone, ErrUnrepairable},
+		{"", "", RepairNone, ErrUnrepairable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, action, err := Repair(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Repair(%q) error = %v; want %v", tt.input, err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("Repair(%q) = %q; want %q", tt.input, got.String(), tt.want)
+			}
+			if action != tt.wantAction {
+				t.Errorf("Repair(%q) action = %v; want %v", tt.input, action, tt.wantAction)
+			}
+		})
+	}
+}
+
+func TestRepairAction_String(t *testing.T) {
+	tests := []struct {
+		action   RepairAction
+		expected string
+	}{
+		{RepairNone, "none"},
+		{RepairScientific, "scientific notation"},
+		{RepairDecimalSuffix | RepairMissingDV, "decimal suffix, missing check digit"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.action.String(); got != tt.expected {
+			t.Errorf("RepairAction(%d).String() = %q; want %q", tt.action, got, tt.expected)
+		}
+	}
+}
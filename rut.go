@@ -5,10 +5,12 @@
 //   - With dots and dash: "12.345.678-9"
 //   - Only dash: "12345678-9"
 //   - No separators: "123456789"
+//   - With spaces and dash: "12 345 678-9"
 package rut
 
 import (
 	"errors"
+	"io"
 	"strconv"
 	"strings"
 )
@@ -17,8 +19,25 @@ import (
 var (
 	ErrInvalidFormat = errors.New("rut: invalid format")
 	ErrEmptyRUT      = errors.New("rut: empty string")
-	ErrTooShort      = errors.New("rut: too short (minimum 5 characters)")
-	ErrTooLong       = errors.New("rut: too long (maximum 10 characters)")
+	ErrTooShort      = errors.New("rut: too short")
+	ErrTooLong       = errors.New("rut: too long")
+	ErrInvalidDV     = errors.New("rut: invalid check digit")
+)
+
+// Length bounds of a RUT without separators (digits + check digit).
+const (
+	minLength = 5
+	maxLength = 10
+
+	// maxParserLength is the longest length a Parser can be configured to
+	// accept: 19 on 64-bit platforms and 10 on 32-bit ones, so the number
+	// always fits in an int.
+	maxParserLength = 1 + 9*strconv.IntSize/32
+
+	// maxNumber is the largest number a Parser accepts at that length:
+	// 999.999.999.999.999.999 on 64-bit platforms and 999.999.999 on
+	// 32-bit ones.
+	maxNumber = 999_999_999 + strconv.IntSize/64*999_999_999_000_000_000
 )
 
 // FormatStyle defines the formatting style for the RUT.
@@ -31,11 +50,25 @@ const (
 	FormatEscaped
 	// FormatWithDash formats as "12345678-9" (dash only)
 	FormatWithDash
+	// FormatSpaces formats as "12 345 678-9" (spaces and dash)
+	FormatSpaces
+	// FormatNumberOnly formats as "12345678" (number without check digit)
+	FormatNumberOnly
+	// FormatNumberDots formats as "12.345.678" (dotted number without check digit)
+	FormatNumberDots
+	// FormatPreserve keeps the style of the input in Reformat. Elsewhere it
+	// formats like FormatComplete.
+	FormatPreserve
 )
 
 // multipliers is a lookup table for the check digit calculation
 var multipliers = [6]int{2, 3, 4, 5, 6, 7}
 
+// isSeparator reports whether c is a separator ignored during parsing.
+func isSeparator(c byte) bool {
+	return c == '.' || c == '-' || c == ' '
+}
+
 // isValidRUTChar checks if a character is valid for a RUT and normalizes it.
 // Returns the normalized character and true if valid, 0 and false otherwise.
 func isValidRUTChar(c byte) (byte, bool) {
@@ -58,69 +91,220 @@ type RUT struct {
 // It accepts formats with or without dots and with or without dash.
 // Case insensitive for 'K'.
 func Validate(rut string) bool {
-	r, err := Parse(rut)
-	if err != nil {
+	return validate(rut)
+}
+
+// ValidateBytes is like Validate but takes a byte slice.
+// It does not retain b.
+func ValidateBytes(b []byte) bool {
+	return validate(b)
+}
+
+// validate implements Validate and ValidateBytes in a single pass from
+// right to left, accumulating the check digit sum without building a RUT.
+func validate[T string | []byte](s T) bool {
+	var (
+		dv  byte
+		sum int
+		n   int
+	)
+
+	for i := len(s) - 1; i >= 0; i-- {
+		c := s[i]
+		if isSeparator(c) {
+			continue
+		}
+		if n == maxLength {
+			return false
+		}
+
+		if n == 0 {
+			char, ok := isValidRUTChar(c)
+			if !ok {
+				return false
+			}
+			dv = char
+		} else {
+			if c < '0' || c > '9' {
+				return false
+			}
+			sum += int(c-'0') * multipliers[(n-1)%6]
+		}
+		n++
+	}
+
+	// A zero sum means the number itself is zero
+	if n < minLength || sum == 0 {
 		return false
 	}
-	return r.Validate()
+	return dv == dvFromSum(sum)
 }
 
 // Parse extracts the number and check digit from a RUT string.
 // It returns an error if the format is invalid or the length is out of bounds.
 func Parse(s string) (RUT, error) {
-	if s == "" {
-		return RUT{}, ErrEmptyRUT
+	return parse(s, minLength, maxLength)
+}
+
+// MustParse is like Parse but panics if the string cannot be parsed.
+// It simplifies safe initialization of package level variables and test
+// fixtures holding RUT literals.
+func MustParse(s string) RUT {
+	r, err := Parse(s)
+	if err != nil {
+		panic(`rut: MustParse(` + strconv.Quote(s) + `): ` + err.Error())
+	}
+	return r
+}
+
+// ParsePadded is like Parse but also returns the number of digits the
+// number was written with, including leading zeros. Passing it as
+// FormatOptions.PadWidth reproduces the original padding:
+//
+//	r, width, _ := rut.ParsePadded("09.123.456-7")
+//	opts := rut.FormatComplete.Options()
+//	opts.PadWidth = width
+//	r.FormatWith(opts) // "09.123.456-7"
+func ParsePadded(s string) (RUT, int, error) {
+	r, err := Parse(s)
+	if err != nil {
+		return RUT{}, 0, err
+	}
+	width := -1 // Do not count the check digit
+	for i := 0; i < len(s); i++ {
+		if !isSeparator(s[i]) {
+			width++
+		}
+	}
+	return r, width, nil
+}
+
+// FromParts builds a RUT from a number and a check digit stored in separate
+// columns. The number may contain dots or spaces, and both parts are
+// validated as Parse would do for the joined value.
+func FromParts(number, dv string) (RUT, error) {
+	number = strings.TrimSpace(number)
+	dv = strings.TrimSpace(dv)
+	if number == "" && dv == "" {
+		return RUT{}, newParseError("", -1, CodeEmpty)
+	}
+	if len(dv) != 1 || strings.IndexByte(number, '-') >= 0 {
+		return RUT{}, newParseError(number+"-"+dv, -1, CodeInvalidParts)
+	}
+	return Parse(number + "-" + dv)
+}
+
+// New builds a RUT from its number, computing the check digit. It returns
+// ErrOutOfRange if the number is below 1 or does not fit in an int, so
+// values read as int64 behave the same on 32 and 64-bit platforms.
+func New(number int64) (RUT, error) {
+	if number < 1 || int64(int(number)) != number {
+		return RUT{}, ErrOutOfRange
+	}
+	return RUT{Number: int(number), DV: CalculateDV(int(number))}, nil
+}
+
+// NewWithDV is like New but takes the check digit too, returning
+// ErrInvalidDV if it does not match the number. A lowercase 'k' is
+// accepted.
+func NewWithDV(number int64, dv byte) (RUT, error) {
+	r, err := New(number)
+	if err != nil {
+		return RUT{}, err
+	}
+	if dv == 'k' {
+		dv = 'K'
+	}
+	if dv != r.DV {
+		return RUT{}, ErrInvalidDV
+	}
+	return r, nil
+}
+
+// Int64 returns the number of the RUT as an int64, for storage in 64-bit
+// columns and protocol fields regardless of the platform int size.
+func (r RUT) Int64() int64 {
+	return int64(r.Number)
+}
+
+// ParseStrict is like Parse but also verifies the check digit, returning
+// ErrInvalidDV for a well formed RUT whose check digit does not match.
+func ParseStrict(s string) (RUT, error) {
+	r, err := Parse(s)
+	if err != nil {
+		return RUT{}, err
+	}
+	if !r.Validate() {
+		return RUT{}, dvError(s)
+	}
+	return r, nil
+}
+
+// ParseBytes is like Parse but takes a byte slice, avoiding a string
+// conversion when reading records from a bufio.Scanner or CSV reader.
+// It does not retain b.
+func ParseBytes(b []byte) (RUT, error) {
+	return parse(b, minLength, maxLength)
+}
+
+// parse implements Parse and ParseBytes with the given length bounds,
+// which must lie within 2 and maxParserLength. Errors are *ParseError.
+func parse[T string | []byte](s T, minLen, maxLen int) (RUT, error) {
+	if len(s) == 0 {
+		return RUT{}, newParseError(s, -1, CodeEmpty)
 	}
 
-	// Clean separators and validate characters
+	// Single pass: every valid character is first held as the candidate
+	// check digit and folded into the number once another one follows.
 	var (
-		raw [12]byte
-		n   int
+		num       int
+		dv        byte
+		dvPos     int
+		n         int
+		misplaced = -1 // Position of the first K before the check digit
 	)
 
 	for i := 0; i < len(s); i++ {
 		c := s[i]
-		if c == '.' || c == '-' {
+		if isSeparator(c) {
 			continue
 		}
-		if n >= 12 {
-			return RUT{}, ErrTooLong
+		// maxLen never exceeds maxParserLength, so num cannot overflow
+		if n >= maxLen {
+			perr := newParseError(s, i, CodeTooLong)
+			perr.Limit = maxLen
+			return RUT{}, perr
 		}
 
 		// Validate and normalize character
 		char, ok := isValidRUTChar(c)
 		if !ok {
-			return RUT{}, ErrInvalidFormat
+			return RUT{}, newParseError(s, i, CodeInvalidChar)
 		}
 
-		raw[n] = char
+		if n > 0 {
+			// 'K' is only allowed as the check digit
+			if dv == 'K' {
+				if misplaced < 0 {
+					misplaced = dvPos
+				}
+			} else {
+				num = num*10 + int(dv-'0')
+			}
+		}
+		dv = char
+		dvPos = i
 		n++
 	}
 
-	// Length validation (5 to 10 characters as requested)
-	// We count the digits + DV
-	if n < 5 {
-		return RUT{}, ErrTooShort
-	}
-	if n > 10 {
-		return RUT{}, ErrTooLong
-	}
-
-	// DV is the last character
-	dv := raw[n-1]
-
-	// Check if 'K' is in the wrong place
-	for i := 0; i < n-1; i++ {
-		if raw[i] == 'K' {
-			return RUT{}, ErrInvalidFormat
-		}
+	// Length validation, counting the digits + DV
+	if n < minLen {
+		perr := newParseError(s, -1, CodeTooShort)
+		perr.Limit = minLen
+		return RUT{}, perr
 	}
-
-	// Parse number
-	numStr := string(raw[:n-1])
-	num, err := strconv.Atoi(numStr)
-	if err != nil {
-		return RUT{}, ErrInvalidFormat
+	if misplaced >= 0 {
+		return RUT{}, newParseError(s, misplaced, CodeMisplacedK)
 	}
 
 	return RUT{
@@ -155,6 +339,11 @@ func CalculateDV(number int) byte {
 		multiplierIdx = (multiplierIdx + 1) % 6
 	}
 
+	return dvFromSum(sum)
+}
+
+// dvFromSum maps the weighted digit sum to its check digit (modulo 11).
+func dvFromSum(sum int) byte {
 	remainder := sum % 11
 	checkResult := 11 - remainder
 
@@ -175,46 +364,91 @@ func (r RUT) String() string {
 
 // Format returns the RUT formatted according to the specified style.
 func (r RUT) Format(style FormatStyle) string {
-	numStr := strconv.Itoa(r.Number)
+	// Max length is 25: 123.456.789.012.345.678-K
+	var buf [25]byte
+	return string(r.AppendFormat(buf[:0], style))
+}
+
+// AppendFormat is like Format but appends the formatted RUT to dst and
+// returns the extended buffer, so callers can reuse a buffer across calls.
+// The zero RUT, which stands for an unset value, formats as "" in every
+// style, as in MarshalText.
+func (r RUT) AppendFormat(dst []byte, style FormatStyle) []byte {
+	if r.IsZero() {
+		return dst
+	}
+	var buf [20]byte
+	digits := strconv.AppendInt(buf[:0], int64(r.Number), 10)
 
 	switch style {
 	case FormatEscaped:
-		var b strings.Builder
-		b.Grow(len(numStr) + 1)
-		b.WriteString(numStr)
-		b.WriteByte(r.DV)
-		return b.String()
+		dst = append(dst, digits...)
 
 	case FormatWithDash:
-		var b strings.Builder
-		b.Grow(len(numStr) + 2)
-		b.WriteString(numStr)
-		b.WriteByte('-')
-		b.WriteByte(r.DV)
-		return b.String()
+		dst = append(dst, digits...)
+		dst = append(dst, '-')
+
+	case FormatSpaces:
+		dst = appendGrouped(dst, digits, ' ')
+		dst = append(dst, '-')
+
+	case FormatNumberOnly:
+		return append(dst, digits...)
+
+	case FormatNumberDots:
+		return appendGrouped(dst, digits, '.')
 
 	case FormatComplete:
 		fallthrough
 	default:
-		// Format: XX.XXX.XXX-X
-		var b strings.Builder
-		// Max length is 12: 12.345.678-K
-		b.Grow(12)
-
-		n := len(numStr)
-		for i, c := range numStr {
-			b.WriteRune(c)
-			// Add dots from right to left every 3 digits
-			distFromEnd := n - i - 1
-			if distFromEnd > 0 && distFromEnd%3 == 0 {
-				b.WriteByte('.')
-			}
+		dst = appendGrouped(dst, digits, '.')
+		dst = append(dst, '-')
+	}
+
+	return append(dst, r.DV)
+}
+
+// appendGrouped appends digits adding sep from right to left every 3 digits.
+func appendGrouped(dst, digits []byte, sep byte) []byte {
+	n := len(digits)
+	for i, c := range digits {
+		dst = append(dst, c)
+		distFromEnd := n - i - 1
+		if distFromEnd > 0 && distFromEnd%3 == 0 {
+			dst = append(dst, sep)
 		}
+	}
+	return dst
+}
+
+// WriteFormatted writes the RUT formatted according to the specified style
+// to w, returning the number of bytes written.
+func (r RUT) WriteFormatted(w io.Writer, style FormatStyle) (int, error) {
+	var buf [25]byte
+	return w.Write(r.AppendFormat(buf[:0], style))
+}
+
+// IsZero reports whether r is the zero RUT, which stands for an unset
+// value. It makes the omitzero JSON option skip unset RUT fields.
+func (r RUT) IsZero() bool {
+	return r == RUT{}
+}
+
+// Ptr returns a pointer to a copy of r, or nil if r is the zero RUT, for
+// optional fields in API structs.
+func (r RUT) Ptr() *RUT {
+	if r.IsZero() {
+		return nil
+	}
+	return &r
+}
 
-		b.WriteByte('-')
-		b.WriteByte(r.DV)
-		return b.String()
+// FromPtr returns the RUT p points to, or the zero RUT if p is nil.
+func FromPtr(p *RUT) RUT {
+	if p == nil {
+		return RUT{}
 	}
+	return *p
 }
 
 // Validate checks if the RUT's check digit matches the calculated one.
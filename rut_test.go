@@ -1,6 +1,11 @@
 package rut
 
 import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -16,6 +21,8 @@ func TestValidate(t *testing.T) {
 		{"1.009-K", true},
 		{"7.654.321-6", true},
 		{"11.111.111-1", true},
+		{"12 345 678-5", true},
+		{" 1 009-K ", true},
 		{"12.345.678-0", false}, // Invalid DV
 		{"1.234.567-4", true},
 		{"5.555.555-5", false},
@@ -34,6 +41,25 @@ func TestValidate(t *testing.T) {
 	}
 }
 
+func TestValidateBytes(t *testing.T) {
+	inputs := []string{
+		"12.345.678-5", "12345678-5", "123456785", "1.009-k", "1.009-K",
+		"12.345.678-0", "", "123", "12.345.678.901-2", "abc-d", "12.34K.678-5",
+		"0000-0", "00001-9", "1234-3", "999.999.999-4", "1.000.000.000-6", "K1234-5",
+	}
+
+	for _, input := range inputs {
+		r, err := Parse(input)
+		want := err == nil && r.Validate()
+		if got := ValidateBytes([]byte(input)); got != want {
+			t.Errorf("ValidateBytes(%q) = %v; want %v", input, got, want)
+		}
+		if got := Validate(input); got != want {
+			t.Errorf("Validate(%q) = %v; want %v", input, got, want)
+		}
+	}
+}
+
 func TestParse(t *testing.T) {
 	tests := []struct {
 		input   string
@@ -44,6 +70,7 @@ func TestParse(t *testing.T) {
 	}{
 		{"12.345.678-5", 12345678, '5', false, nil},
 		{"1.009-K", 1009, 'K', false, nil},
+		{"12 345 678-5", 12345678, '5', false, nil},
 		{"1-9", 0, 0, true, ErrTooShort},
 		{"1234-5", 1234, '5', false, nil}, // Minimum valid (5 chars)
 		{"12345678901", 0, 0, true, ErrTooLong},
@@ -69,6 +96,189 @@ func TestParse(t *testing.T) {
 	}
 }
 
+func TestParseBytes(t *testing.T) {
+	for _, input := range []string{"12.345.678-5", "1.009-k", "1-9", "", "12.34K.678-5", "12345678901"} {
+		want, wantErr := Parse(input)
+		got, err := ParseBytes([]byte(input))
+		if got != want || !reflect.DeepEqual(err, wantErr) {
+			t.Errorf("ParseBytes(%q) = %v, %v; want %v, %v", input, got, err, want, wantErr)
+		}
+	}
+}
+
+func TestParse_Allocs(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		Parse("12.345.678-5")
+	})
+	if allocs != 0 {
+		t.Errorf("Parse() allocs = %v; want 0", allocs)
+	}
+
+	b := []byte("12.345.678-5")
+	allocs = testing.AllocsPerRun(100, func() {
+		ParseBytes(b)
+	})
+	if allocs != 0 {
+		t.Errorf("ParseBytes() allocs = %v; want 0", allocs)
+	}
+}
+
+func TestParsePadded(t *testing.T) {
+	tests := []struct {
+		input     string
+		wantNum   int
+		wantWidth int
+		formatted string
+	}{
+		{"09.123.456-7", 9123456, 8, "09.123.456-7"},
+		{"9.123.456-7", 9123456, 7, "9.123.456-7"},
+		{"0001009-K", 1009, 7, "0.001.009-K"},
+		{" 12.345.678-5 ", 12345678, 8, "12.345.678-5"},
+	}
+
+	for _, tt := range tests {
+		got, width, err := ParsePadded(tt.input)
+		if err != nil {
+			t.Fatalf("ParsePadded(%q) error = %v", tt.input, err)
+		}
+		if got.Number != tt.wantNum || width != tt.wantWidth {
+			t.Errorf("ParsePadded(%q) = %d, %d; want %d, %d", tt.input, got.Number, width, tt.wantNum, tt.wantWidth)
+		}
+
+		opts := FormatComplete.Options()
+		opts.PadWidth = width
+		if s := got.FormatWith(opts); s != tt.formatted {
+			t.Errorf("FormatWith(PadWidth: %d) = %q; want %q", width, s, tt.formatted)
+		}
+	}
+
+	if _, _, err := ParsePadded("abc"); !errors.Is(err, ErrInvalidFormat) {
+		t.Errorf("ParsePadded(%q) error = %v; want %v", "abc", err, ErrInvalidFormat)
+	}
+}
+
+func TestFromParts(t *testing.T) {
+	tests := []struct {
+		number  string
+		dv      string
+		want    RUT
+		wantErr error
+	}{
+		{"12345678", "5", RUT{Number: 12345678, DV: '5'}, nil},
+		{"12.345.678", "5", RUT{Number: 12345678, DV: '5'}, nil},
+		{" 1009 ", "k", RUT{Number: 1009, DV: 'K'}, nil},
+		{"", "", RUT{}, ErrEmptyRUT},
+		{"12345678", "", RUT{}, ErrInvalidFormat},
+		{"12345678", "55", RUT{}, ErrInvalidFormat},
+		{"1234567-8", "5", RUT{}, ErrInvalidFormat},
+		{"12", "5", RUT{}, ErrTooShort},
+		{"12K45678", "5", RUT{}, ErrInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		got, err := FromParts(tt.number, tt.dv)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("FromParts(%q, %q) error = %v; want %v", tt.number, tt.dv, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("FromParts(%q, %q) = %v; want %v", tt.number, tt.dv, got, tt.want)
+		}
+	}
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		number  int64
+		want    RUT
+		wantErr error
+	}{
+		{12345678, RUT{12345678, '5'}, nil},
+		{1009, RUT{1009, 'K'}, nil},
+		{0, RUT{}, ErrOutOfRange},
+		{-12345678, RUT{}, ErrOutOfRange},
+	}
+
+	for _, tt := range tests {
+		got, err := New(tt.number)
+		if got != tt.want || !errors.Is(err, tt.wantErr) {
+			t.Errorf("New(%d) = %v, %v; want %v, %v", tt.number, got, err, tt.want, tt.wantErr)
+		}
+	}
+
+	if strconv.IntSize == 32 {
+		if _, err := New(1 << 40); !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("New(1<<40) error = %v; want %v", err, ErrOutOfRange)
+		}
+	}
+}
+
+func TestNewWithDV(t *testing.T) {
+	tests := []struct {
+		number  int64
+		dv      byte
+		wantErr error
+	}{
+		{12345678, '5', nil},
+		{1009, 'k', nil},
+		{1009, 'K', nil},
+		{12345678, '0', ErrInvalidDV},
+		{0, '0', ErrOutOfRange},
+	}
+
+	for _, tt := range tests {
+		if _, err := NewWithDV(tt.number, tt.dv); !errors.Is(err, tt.wantErr) {
+			t.Errorf("NewWithDV(%d, %q) error = %v; want %v", tt.number, tt.dv, err, tt.wantErr)
+		}
+	}
+}
+
+func TestRUT_Int64(t *testing.T) {
+	if got := MustParse("12.345.678-5").Int64(); got != 12345678 {
+		t.Errorf("Int64() = %d; want 12345678", got)
+	}
+}
+
+func TestParseStrict(t *testing.T) {
+	tests := []struct {
+		input   string
+		wantNum int
+		wantErr error
+	}{
+		{"12.345.678-5", 12345678, nil},
+		{"1.009-k", 1009, nil},
+		{"12.345.678-0", 0, ErrInvalidDV},
+		{"12.34K.678-5", 0, ErrInvalidFormat},
+		{"1-9", 0, ErrTooShort},
+		{"", 0, ErrEmptyRUT},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseStrict(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseStrict(%q) error = %v; want %v", tt.input, err, tt.wantErr)
+			}
+			if got.Number != tt.wantNum {
+				t.Errorf("ParseStrict(%q) Number = %v, want %v", tt.input, got.Number, tt.wantNum)
+			}
+		})
+	}
+}
+
+func TestMustParse(t *testing.T) {
+	if got := MustParse("12.345.678-5"); got.Number != 12345678 || got.DV != '5' {
+		t.Errorf("MustParse() = %v; want 12.345.678-5", got)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParse(\"abc\") did not panic")
+		}
+	}()
+	MustParse("abc")
+}
+
 func TestFormat(t *testing.T) {
 	input := "123456785"
 
@@ -80,6 +290,9 @@ func TestFormat(t *testing.T) {
 		{"Complete", FormatComplete, "12.345.678-5"},
 		{"Escaped", FormatEscaped, "123456785"},
 		{"WithDash", FormatWithDash, "12345678-5"},
+		{"Spaces", FormatSpaces, "12 345 678-5"},
+		{"NumberOnly", FormatNumberOnly, "12345678"},
+		{"NumberDots", FormatNumberDots, "12.345.678"},
 	}
 
 	for _, tt := range tests {
@@ -95,12 +308,78 @@ func TestFormat(t *testing.T) {
 	}
 }
 
+func TestRUT_AppendFormat(t *testing.T) {
+	tests := []struct {
+		r        RUT
+		style    FormatStyle
+		expected string
+	}{
+		{RUT{Number: 12345678, DV: '5'}, FormatComplete, "prefix:12.345.678-5"},
+		{RUT{Number: 12345678, DV: '5'}, FormatEscaped, "prefix:123456785"},
+		{RUT{Number: 12345678, DV: '5'}, FormatWithDash, "prefix:12345678-5"},
+		{RUT{Number: 1009, DV: 'K'}, FormatComplete, "prefix:1.009-K"},
+		{RUT{Number: 123456789, DV: '2'}, FormatComplete, "prefix:123.456.789-2"},
+		{RUT{Number: 999, DV: '7'}, FormatComplete, "prefix:999-7"},
+		{RUT{Number: 1009, DV: 'K'}, FormatSpaces, "prefix:1 009-K"},
+		{RUT{}, FormatComplete, "prefix:"},
+		{RUT{}, FormatEscaped, "prefix:"},
+		{RUT{}, FormatNumberDots, "prefix:"},
+	}
+
+	for _, tt := range tests {
+		got := tt.r.AppendFormat([]byte("prefix:"), tt.style)
+		if string(got) != tt.expected {
+			t.Errorf("AppendFormat(%v, %v) = %q; want %q", tt.r, tt.style, got, tt.expected)
+		}
+		if want := "prefix:" + tt.r.Format(tt.style); string(got) != want {
+			t.Errorf("AppendFormat(%v, %v) = %q; want Format() result %q", tt.r, tt.style, got, want)
+		}
+	}
+
+	buf := make([]byte, 0, 16)
+	r := RUT{Number: 12345678, DV: '5'}
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = r.AppendFormat(buf[:0], FormatComplete)
+	})
+	if allocs != 0 {
+		t.Errorf("AppendFormat() allocs = %v; want 0", allocs)
+	}
+}
+
+func TestRUT_WriteFormatted(t *testing.T) {
+	var b strings.Builder
+	r := RUT{Number: 1009, DV: 'K'}
+
+	n, err := r.WriteFormatted(&b, FormatWithDash)
+	if err != nil {
+		t.Fatalf("WriteFormatted() error = %v", err)
+	}
+	if n != 6 || b.String() != "1009-K" {
+		t.Errorf("WriteFormatted() = %d, %q; want 6, %q", n, b.String(), "1009-K")
+	}
+
+	wantErr := errors.New("write failed")
+	if _, err := r.WriteFormatted(errWriter{wantErr}, FormatComplete); err != wantErr {
+		t.Errorf("WriteFormatted() error = %v; want %v", err, wantErr)
+	}
+}
+
+type errWriter struct{ err error }
+
+func (w errWriter) Write([]byte) (int, error) { return 0, w.err }
+
 func TestRUT_String(t *testing.T) {
 	r := RUT{Number: 12345678, DV: '5'}
 	expected := "12.345.678-5"
 	if got := r.String(); got != expected {
 		t.Errorf("RUT.String() = %q; want %q", got, expected)
 	}
+	if got := (RUT{}).String(); got != "" {
+		t.Errorf("RUT{}.String() = %q; want \"\"", got)
+	}
+	if got := fmt.Sprint(RUT{}); got != "" {
+		t.Errorf("fmt.Sprint(RUT{}) = %q; want \"\"", got)
+	}
 }
 
 func TestCalculateDV(t *testing.T) {
@@ -113,6 +392,8 @@ func TestCalculateDV(t *testing.T) {
 		{11111111, '1'},
 		{1009, 'K'},
 		{14555848, '4'},
+		{999999999, '6'},
+		{1234567890, '3'},
 		{0, '0'},
 	}
 
@@ -122,3 +403,49 @@ func TestCalculateDV(t *testing.T) {
 		}
 	}
 }
+
+func TestRUT_Format_Large(t *testing.T) {
+	r := RUT{Number: 1234567890, DV: '3'}
+	tests := []struct {
+		style    FormatStyle
+		expected string
+	}{
+		{FormatComplete, "1.234.567.890-3"},
+		{FormatSpaces, "1 234 567 890-3"},
+		{FormatWithDash, "1234567890-3"},
+		{FormatEscaped, "12345678903"},
+		{FormatNumberDots, "1.234.567.890"},
+	}
+
+	for _, tt := range tests {
+		if got := r.Format(tt.style); got != tt.expected {
+			t.Errorf("Format(%v) = %q; want %q", tt.style, got, tt.expected)
+		}
+	}
+}
+
+func TestRUT_IsZero(t *testing.T) {
+	if !(RUT{}).IsZero() {
+		t.Error("RUT{}.IsZero() = false; want true")
+	}
+	if MustParse("1.009-K").IsZero() {
+		t.Error("IsZero() = true; want false")
+	}
+}
+
+func TestRUT_Ptr(t *testing.T) {
+	if p := (RUT{}).Ptr(); p != nil {
+		t.Errorf("RUT{}.Ptr() = %v; want nil", p)
+	}
+	r := MustParse("1.009-K")
+	p := r.Ptr()
+	if p == nil || *p != r {
+		t.Fatalf("Ptr() = %v; want a pointer to %v", p, r)
+	}
+	if got := FromPtr(p); got != r {
+		t.Errorf("FromPtr() = %v; want %v", got, r)
+	}
+	if got := FromPtr(nil); !got.IsZero() {
+		t.Errorf("FromPtr(nil) = %v; want the zero RUT", got)
+	}
+}
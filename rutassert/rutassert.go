@@ -0,0 +1,159 @@
+// Package rutassert provides test helpers that check RUTs and explain
+// failures in detail, so downstream test suites need less boilerplate:
+//
+//	func TestSignup(t *testing.T) {
+//		user := signup(t, "12.345.678-5")
+//		rutassert.AssertValid(t, user.RUT.String())
+//		rutassert.AssertCanonical(t, user.RUT.String(), "12345678-5")
+//		rutassert.AssertKind(t, user.RUT.String(), rut.KindPerson)
+//	}
+//
+// The helpers report failures with t.Errorf, so a test can check several
+// values before stopping, and return whether the assertion held.
+package rutassert
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/jestays/rut-go"
+)
+
+// AssertValid checks that s parses and has a valid check digit. On
+// failure it reports the error code and position, or the expected check
+// digit, and any warning of rut.Check.
+func AssertValid(t testing.TB, s string) bool {
+	t.Helper()
+	res := rut.Check(s)
+	if res.Valid() {
+		return true
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "rutassert: %q is not a valid RUT\n", s)
+	describe(&b, res)
+	t.Errorf("%s", strings.TrimSuffix(b.String(), "\n"))
+	return false
+}
+
+// AssertInvalid checks that s does not parse or has a wrong check digit.
+func AssertInvalid(t testing.TB, s string) bool {
+	t.Helper()
+	res := rut.Check(s)
+	if !res.Valid() {
+		return true
+	}
+	t.Errorf("rutassert: %q is a valid RUT (%s, %s); want invalid", s, res.Canonical, res.Kind)
+	return false
+}
+
+// AssertCanonical checks that got is exactly want in rut.FormatComplete
+// style, "12.345.678-5", with an uppercase K. want may be written in any
+// style. On failure it marks the differing characters and tells whether
+// got is the same RUT in another style.
+func AssertCanonical(t testing.TB, got, want string) bool {
+	t.Helper()
+	w, err := rut.Parse(want)
+	if err != nil {
+		t.Errorf("rutassert: want %q is not a RUT: %v", want, err)
+		return false
+	}
+	canonical := strings.ToUpper(w.Format(rut.FormatComplete))
+	if got == canonical {
+		return true
+	}
+
+	var b strings.Builder
+	b.WriteString("rutassert: RUT is not canonical\n")
+	fmt.Fprintf(&b, "\tgot:  %q\n", got)
+	fmt.Fprintf(&b, "\twant: %q\n", canonical)
+	fmt.Fprintf(&b, "\t       %s\n", carets(got, canonical))
+	switch g, err := rut.Parse(got); {
+	case err != nil:
+		fmt.Fprintf(&b, "\tgot does not parse: %v", err)
+	case g.Equal(w):
+		b.WriteString("\tsame RUT in another style")
+	case g.Number == w.Number:
+		fmt.Fprintf(&b, "\tsame number, check digit %c; want %c", g.DV, w.DV)
+	default:
+		fmt.Fprintf(&b, "\tdifferent RUT: number %d; want %d", g.Number, w.Number)
+	}
+	t.Errorf("%s", b.String())
+	return false
+}
+
+// AssertKind checks that s is a valid RUT of the given kind. On failure it
+// reports the kind found and the number ranges of both kinds.
+func AssertKind(t testing.TB, s string, kind rut.Kind) bool {
+	t.Helper()
+	res := rut.Check(s)
+	if !res.Valid() {
+		var b strings.Builder
+		fmt.Fprintf(&b, "rutassert: %q is not a valid RUT; want kind %s\n", s, kind)
+		describe(&b, res)
+		t.Errorf("%s", strings.TrimSuffix(b.String(), "\n"))
+		return false
+	}
+	if res.Kind == kind {
+		return true
+	}
+	t.Errorf("rutassert: %s has kind %s; want %s\n\tgot:  %s\n\twant: %s",
+		res.Canonical, res.Kind, kind, kindRange(res.Kind), kindRange(kind))
+	return false
+}
+
+// describe writes the reasons res is invalid, one per line.
+func describe(b *strings.Builder, res rut.ValidationResult) {
+	if perr, ok := res.Err.(*rut.ParseError); ok && perr.Code != rut.CodeInvalidDV {
+		fmt.Fprintf(b, "\terror: %v (%s)\n", perr.Err, perr.Code)
+		if perr.Pos >= 0 {
+			fmt.Fprintf(b, "\t       %q\n", res.Input)
+			fmt.Fprintf(b, "\t        %s^\n", strings.Repeat(" ", quotedOffset(res.Input, perr.Pos)))
+		}
+	} else if !res.ValidDV {
+		fixed := rut.RUT{Number: res.RUT.Number, DV: rut.CalculateDV(res.RUT.Number)}
+		fmt.Fprintf(b, "\tcheck digit: %c; want %c (%s)\n", res.RUT.DV, fixed.DV, fixed)
+	}
+	if len(res.Warnings) > 0 {
+		fmt.Fprintf(b, "\twarnings: %v\n", res.Warnings)
+	}
+}
+
+// carets returns a line marking with ^ the characters where a and b
+// differ, aligned under their quoted forms.
+func carets(a, b string) string {
+	qa, qb := []rune(fmt.Sprintf("%q", a)), []rune(fmt.Sprintf("%q", b))
+	line := make([]rune, max(len(qa), len(qb)))
+	for i := range line {
+		line[i] = ' '
+		if i >= len(qa) || i >= len(qb) || qa[i] != qb[i] {
+			line[i] = '^'
+		}
+	}
+	return strings.TrimRight(string(line[1:]), " ")
+}
+
+// quotedOffset returns the column of the byte at pos in the quoted form of
+// s, without the opening quote.
+func quotedOffset(s string, pos int) int {
+	return len([]rune(fmt.Sprintf("%q", s[:pos]))) - 2
+}
+
+// kindRange describes the number range of k.
+func kindRange(k rut.Kind) string {
+	switch k {
+	case rut.KindPerson:
+		return numberRange(k, rut.MinPersonNumber, rut.MaxPersonNumber)
+	case rut.KindCompany:
+		return numberRange(k, rut.MinCompanyNumber, rut.MaxCompanyNumber)
+	case rut.KindProvisional:
+		return numberRange(k, rut.MinProvisionalNumber, rut.MaxProvisionalNumber)
+	}
+	return k.String()
+}
+
+func numberRange(k rut.Kind, lo, hi int) string {
+	return fmt.Sprintf("%s, %s to %s", k,
+		rut.RUT{Number: lo}.Format(rut.FormatNumberDots),
+		rut.RUT{Number: hi}.Format(rut.FormatNumberDots))
+}
@@ -0,0 +1,114 @@
+package rutassert
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/jestays/rut-go"
+)
+
+// recorder is a testing.TB that records the failures it is given.
+type recorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+// check runs an assertion on a recorder and verifies its result and the
+// failure message, which must contain every string of want.
+func check(t *testing.T, name string, assert func(testing.TB) bool, ok bool, want ...string) {
+	t.Helper()
+	rec := &recorder{TB: t}
+	if got := assert(rec); got != ok || (len(rec.errors) == 0) != ok {
+		t.Errorf("%s = %v with errors %q; want %v", name, got, rec.errors, ok)
+		return
+	}
+	msg := strings.Join(rec.errors, "\n")
+	for _, w := range want {
+		if !strings.Contains(msg, w) {
+			t.Errorf("%s message:\n%s\nwant it to contain %q", name, msg, w)
+		}
+	}
+}
+
+func TestAssertValid(t *testing.T) {
+	tests := []struct {
+		in   string
+		ok   bool
+		want []string
+	}{
+		{"12.345.678-5", true, nil},
+		{"1.009-k", true, nil},
+		{"12.345.678-0", false, []string{"check digit: 0; want 5 (12.345.678-5)", "warnings: [fictitious]"}},
+		{"12.3a5.678-5", false, []string{"invalid_char", "\t       \"12.3a5.678-5\"\n\t            ^"}},
+		{"", false, []string{"empty"}},
+	}
+
+	for _, tt := range tests {
+		check(t, fmt.Sprintf("AssertValid(%q)", tt.in), func(tb testing.TB) bool {
+			return AssertValid(tb, tt.in)
+		}, tt.ok, tt.want...)
+	}
+}
+
+func TestAssertInvalid(t *testing.T) {
+	check(t, `AssertInvalid("12.345.678-0")`, func(tb testing.TB) bool {
+		return AssertInvalid(tb, "12.345.678-0")
+	}, true)
+	check(t, `AssertInvalid("60803000-K")`, func(tb testing.TB) bool {
+		return AssertInvalid(tb, "60803000-K")
+	}, false, "60.803.000-K, company")
+}
+
+func TestAssertCanonical(t *testing.T) {
+	tests := []struct {
+		got, want string
+		ok        bool
+		msg       []string
+	}{
+		{"12.345.678-5", "123456785", true, nil},
+		{"1.009-K", "1009-k", true, nil},
+		{"12345678-5", "12.345.678-5", false, []string{"same RUT in another style", "^^^^^^^^^^^"}},
+		{"1.009-k", "1.009-K", false, []string{"same RUT in another style", "\t             ^"}},
+		{"12.345.678-4", "12.345.678-5", false, []string{"same number, check digit 4; want 5", "\t                  ^"}},
+		{"12.345.687-5", "12.345.678-5", false, []string{"different RUT: number 12345687; want 12345678"}},
+		{"n/a", "12.345.678-5", false, []string{"got does not parse"}},
+		{"12.345.678-5", "bad", false, []string{`want "bad" is not a RUT`}},
+	}
+
+	for _, tt := range tests {
+		check(t, fmt.Sprintf("AssertCanonical(%q, %q)", tt.got, tt.want), func(tb testing.TB) bool {
+			return AssertCanonical(tb, tt.got, tt.want)
+		}, tt.ok, tt.msg...)
+	}
+}
+
+func TestAssertKind(t *testing.T) {
+	tests := []struct {
+		in   string
+		kind rut.Kind
+		ok   bool
+		want []string
+	}{
+		{"12.345.678-5", rut.KindPerson, true, nil},
+		{"60.803.000-K", rut.KindCompany, true, nil},
+		{"60.803.000-K", rut.KindPerson, false, []string{
+			"60.803.000-K has kind company; want person",
+			"got:  company, 50.000.000 to 99.999.999",
+			"want: person, 1 to 49.999.999",
+		}},
+		{"12.345.678-0", rut.KindPerson, false, []string{"not a valid RUT; want kind person", "check digit"}},
+	}
+
+	for _, tt := range tests {
+		check(t, fmt.Sprintf("AssertKind(%q, %v)", tt.in, tt.kind), func(tb testing.TB) bool {
+			return AssertKind(tb, tt.in, tt.kind)
+		}, tt.ok, tt.want...)
+	}
+}
@@ -0,0 +1,9 @@
+module github.com/jestays/rut-go/rutent
+
+go 1.24
+
+require github.com/jestays/rut-go v0.0.0
+
+require entgo.io/ent v0.14.6
+
+replace github.com/jestays/rut-go => ../
@@ -0,0 +1,66 @@
+// Package rutent declares rut.RUT fields in ent schemas, validated and
+// normalized when entities are created or updated.
+//
+//	func (Invoice) Fields() []ent.Field {
+//		return []ent.Field{
+//			rutent.Field("emisor", rut.FormatWithDash),
+//		}
+//	}
+//
+// Field returns a finished ent.Field. To add options such as Unique or
+// Optional, use the builder directly with ValueScanner:
+//
+//	field.String("emisor").
+//		GoType(rut.RUT{}).
+//		ValueScanner(rutent.ValueScanner(rut.FormatWithDash)).
+//		Unique()
+package rutent
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/jestays/rut-go"
+)
+
+// Field returns a string field mapped to rut.RUT in the generated code and
+// stored in the given style, see ValueScanner.
+func Field(name string, style rut.FormatStyle) ent.Field {
+	return field.String(name).
+		GoType(rut.RUT{}).
+		ValueScanner(ValueScanner(style))
+}
+
+// ValueScanner returns an ent value scanner storing RUTs formatted in style,
+// so every row uses the same format whatever the input was. Writing a RUT
+// with a wrong check digit fails with rut.ErrInvalidDV and the zero RUT is
+// stored as NULL. Scanning accepts any format supported by rut.Parse.
+//
+// Styles without a check digit are not supported, since the stored value
+// could not be validated when read back.
+func ValueScanner(style rut.FormatStyle) field.TypeValueScanner[rut.RUT] {
+	return field.ValueScannerFunc[rut.RUT, *sql.NullString]{
+		V: func(r rut.RUT) (driver.Value, error) {
+			switch {
+			case r == rut.RUT{}:
+				return nil, nil
+			case !r.Validate():
+				return nil, rut.ErrInvalidDV
+			case style == rut.FormatNumberOnly || style == rut.FormatNumberDots:
+				return nil, fmt.Errorf("rutent: unsupported column style %d", style)
+			}
+			return r.Format(style), nil
+		},
+		S: func(s *sql.NullString) (rut.RUT, error) {
+			var r rut.RUT
+			if !s.Valid {
+				return r, nil
+			}
+			err := r.UnmarshalText([]byte(s.String))
+			return r, err
+		},
+	}
+}
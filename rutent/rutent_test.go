@@ -0,0 +1,54 @@
+package rutent
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/jestays/rut-go"
+)
+
+func TestValueScanner(t *testing.T) {
+	vs := ValueScanner(rut.FormatWithDash)
+
+	tests := []struct {
+		r       rut.RUT
+		want    any
+		wantErr error
+	}{
+		{rut.MustParse("60.803.000-k"), "60803000-K", nil},
+		{rut.RUT{}, nil, nil},
+		{rut.RUT{Number: 60803000, DV: '1'}, nil, rut.ErrInvalidDV},
+	}
+	for _, tt := range tests {
+		got, err := vs.Value(tt.r)
+		if !errors.Is(err, tt.wantErr) || got != tt.want {
+			t.Errorf("Value(%v) = %v, %v; want %v, %v", tt.r, got, err, tt.want, tt.wantErr)
+		}
+	}
+
+	scanned := vs.ScanValue()
+	if err := scanned.Scan("12.345.678-5"); err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+	got, err := vs.FromValue(scanned)
+	if err != nil || got != rut.MustParse("12345678-5") {
+		t.Errorf("FromValue(12.345.678-5) = %v, %v; want 12.345.678-5, nil", got, err)
+	}
+	if _, err := vs.FromValue(&sql.NullString{String: "12.345.678-0", Valid: true}); !errors.Is(err, rut.ErrInvalidDV) {
+		t.Errorf("FromValue(12.345.678-0) error = %v; want %v", err, rut.ErrInvalidDV)
+	}
+	if got, err := vs.FromValue(&sql.NullString{}); err != nil || got != (rut.RUT{}) {
+		t.Errorf("FromValue(NULL) = %v, %v; want zero RUT, nil", got, err)
+	}
+}
+
+func TestField(t *testing.T) {
+	desc := Field("emisor", rut.FormatComplete).Descriptor()
+	if desc.Err != nil {
+		t.Fatalf("Field() descriptor error = %v", desc.Err)
+	}
+	if desc.Name != "emisor" || desc.Info.Ident != "rut.RUT" || desc.ValueScanner == nil {
+		t.Errorf("Field() = %s of %s; want emisor of rut.RUT with a value scanner", desc.Name, desc.Info.Ident)
+	}
+}
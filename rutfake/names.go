@@ -0,0 +1,35 @@
+package rutfake
+
+// Common given names and surnames in Chile.
+var (
+	givenNames = []string{
+		"Agustín", "Alejandro", "Ana", "Antonia", "Benjamín", "Camila",
+		"Carlos", "Carolina", "Catalina", "Claudia", "Constanza", "Cristián",
+		"Daniela", "Diego", "Fernanda", "Francisca", "Gonzalo", "Ignacio",
+		"Javiera", "Joaquín", "Jorge", "José", "Juan", "Luis", "Manuel",
+		"María", "Martina", "Matías", "Nicolás", "Patricia", "Pedro",
+		"Rodrigo", "Sebastián", "Sofía", "Tomás", "Valentina", "Vicente",
+	}
+	surnames = []string{
+		"Araya", "Carrasco", "Castillo", "Contreras", "Díaz", "Espinoza",
+		"Flores", "Fuentes", "González", "Gutiérrez", "Hernández", "Jara",
+		"López", "Martínez", "Morales", "Muñoz", "Núñez", "Pérez", "Reyes",
+		"Rodríguez", "Rojas", "Sepúlveda", "Silva", "Soto", "Torres",
+		"Valenzuela", "Vargas", "Vera",
+	}
+)
+
+// Parts of company names.
+var (
+	activities = []string{
+		"Agrícola", "Comercial", "Constructora", "Distribuidora",
+		"Importadora", "Ingeniería", "Inmobiliaria", "Inversiones",
+		"Servicios", "Transportes",
+	}
+	places = []string{
+		"Cordillera", "del Pacífico", "del Sur", "El Roble", "La Araucanía",
+		"Las Vertientes", "Los Andes", "Los Aromos", "Río Claro",
+		"Santa Elena",
+	}
+	legalForms = []string{"SpA", "Ltda.", "S.A.", "y Cía. Ltda."}
+)
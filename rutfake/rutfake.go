@@ -0,0 +1,192 @@
+// Package rutfake builds fake people and companies with valid RUTs, for
+// seeding demo environments and tests:
+//
+//	g := rutfake.New(rutfake.WithSeed(42), rutfake.WithAges(25, 40))
+//	p := g.Person() // RUN plausible for someone aged 25 to 40
+//	c := g.Company()
+//
+// Person RUTs fall in the natural person range, from 1.000.000 up, and are
+// coherent with the birth year according to rut.EstimateIssuanceYear;
+// company RUTs fall in the 76 and 77 millions, where most companies
+// registered in the last decades are numbered. Numbers flagged by
+// rut.Signals or rut.RUT.IsFictitious, institutional RUTs and numbers
+// already returned by the Generator are skipped. The names are built from
+// common Chilean names and surnames; any match with a real person or
+// company is a coincidence.
+package rutfake
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/jestays/rut-go"
+)
+
+// Company numbers are drawn from this range.
+const (
+	minCompanyNumber = 76_000_000
+	maxCompanyNumber = 77_999_999
+)
+
+// Person is a fake natural person.
+type Person struct {
+	RUT             rut.RUT
+	GivenName       string
+	PaternalSurname string
+	MaternalSurname string
+	BirthYear       int
+}
+
+// Name returns the full name of p, "Camila Rojas Muñoz".
+func (p Person) Name() string {
+	return p.GivenName + " " + p.PaternalSurname + " " + p.MaternalSurname
+}
+
+// Company is a fake legal entity.
+type Company struct {
+	RUT  rut.RUT
+	Name string
+}
+
+// Generator builds fake people and companies. A Generator is not safe for
+// concurrent use. Since it never repeats a RUT, Person and Company panic
+// once every usable number in their range has been returned, which takes
+// millions of calls.
+type Generator struct {
+	rand   *rand.Rand
+	year   int
+	minAge int
+	maxAge int
+	used   map[int]bool
+}
+
+// Option configures a Generator.
+type Option func(*Generator)
+
+// WithSeed makes the Generator return the same sequence for the same seed
+// and options. Without it the sequence is different on every run.
+func WithSeed(seed int64) Option {
+	return func(g *Generator) {
+		g.rand = rand.New(rand.NewSource(seed))
+	}
+}
+
+// WithAges sets the range of ages of the people, 18 to 80 by default.
+func WithAges(minAge, maxAge int) Option {
+	return func(g *Generator) {
+		g.minAge = max(minAge, 0)
+		g.maxAge = max(maxAge, g.minAge)
+	}
+}
+
+// WithYear sets the current year used to compute birth years from ages,
+// for reproducible fixtures. It defaults to the year of time.Now.
+func WithYear(year int) Option {
+	return func(g *Generator) {
+		g.year = year
+	}
+}
+
+// New returns a Generator configured with the given options.
+func New(opts ...Option) *Generator {
+	g := &Generator{
+		year:   time.Now().Year(),
+		minAge: 18,
+		maxAge: 80,
+		used:   make(map[int]bool),
+	}
+	for _, opt := range opts {
+		opt(g)
+	}
+	if g.rand == nil {
+		g.rand = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+	return g
+}
+
+// Person returns a new fake person. For birth years outside the known
+// issuance bands, such as newborns in years after the bands were compiled,
+// the RUN is drawn from the nearest year covered.
+func (g *Generator) Person() Person {
+	birth := g.year - g.minAge - g.rand.Intn(g.maxAge-g.minAge+1)
+	lo, hi := issuanceNumbers(birth)
+	return Person{
+		RUT:             g.rut(lo, hi),
+		GivenName:       g.pick(givenNames),
+		PaternalSurname: g.pick(surnames),
+		MaternalSurname: g.pick(surnames),
+		BirthYear:       birth,
+	}
+}
+
+// Company returns a new fake company.
+func (g *Generator) Company() Company {
+	words := places
+	if g.rand.Intn(2) == 0 {
+		words = surnames
+	}
+	return Company{
+		RUT:  g.rut(minCompanyNumber, maxCompanyNumber),
+		Name: g.pick(activities) + " " + g.pick(words) + " " + g.pick(legalForms),
+	}
+}
+
+// maxDraws is how many random numbers rut draws before falling back to a
+// scan of the whole range.
+const maxDraws = 1000
+
+// rut returns an unused RUT numbered from lo to hi without fraud signals.
+// Random draws are cheap while the range is mostly free; once they keep
+// missing, rut scans the range from a random point instead, and panics
+// when no usable number is left rather than looping forever.
+func (g *Generator) rut(lo, hi int) rut.RUT {
+	size := hi - lo + 1
+	for i := 0; i < maxDraws; i++ {
+		if r, ok := g.usable(lo + g.rand.Intn(size)); ok {
+			return r
+		}
+	}
+	start := g.rand.Intn(size)
+	for i := 0; i < size; i++ {
+		if r, ok := g.usable(lo + (start+i)%size); ok {
+			return r
+		}
+	}
+	panic(fmt.Sprintf("rutfake: every usable number from %d to %d has been used", lo, hi))
+}
+
+// usable reports whether n can be returned, and marks it as used if so.
+func (g *Generator) usable(n int) (rut.RUT, bool) {
+	r := rut.RUT{Number: n, DV: rut.CalculateDV(n)}
+	if g.used[n] || r.IsFictitious() || len(rut.Signals(r)) > 0 {
+		return rut.RUT{}, false
+	}
+	if _, ok := rut.LookupInstitution(r); ok {
+		return rut.RUT{}, false
+	}
+	g.used[n] = true
+	return r, true
+}
+
+func (g *Generator) pick(s []string) string {
+	return s[g.rand.Intn(len(s))]
+}
+
+// issuanceNumbers is like rut.IssuanceNumbers but falls back to the
+// nearest year covered by the bands, and skips numbers below a million,
+// which rut.Check warns about.
+func issuanceNumbers(year int) (lo, hi int) {
+	lo, hi = 1_000_000, rut.PersonIssuanceCeiling-1
+	for d := 0; d <= 200; d++ {
+		if l, h, ok := rut.IssuanceNumbers(year - d); ok {
+			lo, hi = l, h
+			break
+		}
+		if l, h, ok := rut.IssuanceNumbers(year + d); ok {
+			lo, hi = l, h
+			break
+		}
+	}
+	return max(lo, 1_000_000), hi
+}
@@ -0,0 +1,99 @@
+package rutfake
+
+import (
+	"testing"
+
+	"github.com/jestays/rut-go"
+)
+
+func TestGenerator_Person(t *testing.T) {
+	g := New(WithSeed(1), WithYear(2024), WithAges(20, 70))
+	seen := make(map[rut.RUT]bool)
+	for i := 0; i < 1000; i++ {
+		p := g.Person()
+		if !p.RUT.Validate() || !p.RUT.IsPerson() {
+			t.Fatalf("Person().RUT = %v; want a valid person RUT", p.RUT)
+		}
+		if age := 2024 - p.BirthYear; age < 20 || age > 70 {
+			t.Errorf("Person().BirthYear = %d; want an age from 20 to 70", p.BirthYear)
+		}
+		from, to, ok := rut.EstimateIssuanceYear(p.RUT)
+		if !ok || p.BirthYear < from || p.BirthYear > to {
+			t.Errorf("EstimateIssuanceYear(%v) = %d, %d, %v; want a range including %d", p.RUT, from, to, ok, p.BirthYear)
+		}
+		if res := rut.Check(p.RUT.String()); len(res.Warnings) > 0 {
+			t.Errorf("Check(%v).Warnings = %v; want none", p.RUT, res.Warnings)
+		}
+		if seen[p.RUT] {
+			t.Errorf("Person().RUT = %v; returned twice", p.RUT)
+		}
+		seen[p.RUT] = true
+	}
+}
+
+func TestGenerator_PersonOutsideBands(t *testing.T) {
+	g := New(WithSeed(1), WithYear(2100), WithAges(0, 0))
+	p := g.Person()
+	if p.BirthYear != 2100 || !p.RUT.Validate() || !p.RUT.IsPerson() {
+		t.Errorf("Person() = %+v; want a valid person RUT born in 2100", p)
+	}
+}
+
+func TestGenerator_Company(t *testing.T) {
+	g := New(WithSeed(1))
+	seen := make(map[rut.RUT]bool)
+	for i := 0; i < 1000; i++ {
+		c := g.Company()
+		if !c.RUT.Validate() || !c.RUT.IsCompany() {
+			t.Fatalf("Company().RUT = %v; want a valid company RUT", c.RUT)
+		}
+		if c.Name == "" {
+			t.Errorf("Company().Name = %q; want a name", c.Name)
+		}
+		if seen[c.RUT] {
+			t.Errorf("Company().RUT = %v; returned twice", c.RUT)
+		}
+		seen[c.RUT] = true
+	}
+}
+
+func TestGenerator_RangeExhausted(t *testing.T) {
+	g := New(WithSeed(1))
+	const lo, hi = 10_000_000, 10_000_019
+	seen := make(map[rut.RUT]bool)
+	defer func() {
+		if recover() == nil {
+			t.Errorf("rut(%d, %d) did not panic with the range exhausted", lo, hi)
+		}
+		if len(seen) == 0 {
+			t.Errorf("rut(%d, %d) returned no RUT before panicking", lo, hi)
+		}
+	}()
+	for i := 0; i <= hi-lo+1; i++ {
+		r := g.rut(lo, hi)
+		if seen[r] || r.Number < lo || r.Number > hi {
+			t.Fatalf("rut(%d, %d) = %v; want an unused RUT in range", lo, hi, r)
+		}
+		seen[r] = true
+	}
+}
+
+func TestWithSeed(t *testing.T) {
+	a := New(WithSeed(42), WithYear(2024))
+	b := New(WithSeed(42), WithYear(2024))
+	for i := 0; i < 10; i++ {
+		if pa, pb := a.Person(), b.Person(); pa != pb {
+			t.Fatalf("Person() = %+v and %+v; want the same with the same seed", pa, pb)
+		}
+		if ca, cb := a.Company(), b.Company(); ca != cb {
+			t.Fatalf("Company() = %+v and %+v; want the same with the same seed", ca, cb)
+		}
+	}
+}
+
+func TestPerson_Name(t *testing.T) {
+	p := Person{GivenName: "Camila", PaternalSurname: "Rojas", MaternalSurname: "Muñoz"}
+	if got, want := p.Name(), "Camila Rojas Muñoz"; got != want {
+		t.Errorf("Name() = %q; want %q", got, want)
+	}
+}
@@ -0,0 +1,54 @@
+package rutgorm
+
+import (
+	"github.com/jestays/rut-go"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// NormalizeResult reports the outcome of NormalizeColumn.
+type NormalizeResult struct {
+	Updated int64    // Rows rewritten in the target style
+	Invalid []string // Distinct values that are not valid RUTs, left as is
+}
+
+// NormalizeColumn rewrites every valid RUT in a string column of table in
+// the given style, in a single transaction, so existing rows match what a
+// Serializer writes before it is adopted. Empty and NULL values are
+// skipped, and values that are not valid RUTs are reported and left
+// unchanged for manual review.
+func NormalizeColumn(db *gorm.DB, table, column string, style rut.FormatStyle) (NormalizeResult, error) {
+	var res NormalizeResult
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var values []string
+		col := clause.Column{Name: column}
+		err := tx.Table(table).
+			Where(clause.Neq{Column: col, Value: ""}).
+			Distinct(column).
+			Pluck(column, &values).Error
+		if err != nil {
+			return err
+		}
+
+		for _, v := range values {
+			r, err := rut.Parse(v)
+			if err != nil || !r.Validate() {
+				res.Invalid = append(res.Invalid, v)
+				continue
+			}
+			formatted := r.Format(style)
+			if formatted == v {
+				continue
+			}
+			result := tx.Table(table).
+				Where(clause.Eq{Column: col, Value: v}).
+				Update(column, formatted)
+			if result.Error != nil {
+				return result.Error
+			}
+			res.Updated += result.RowsAffected
+		}
+		return nil
+	})
+	return res, err
+}
@@ -0,0 +1,113 @@
+// Package rutgorm stores rut.RUT fields with GORM in a configurable column
+// format, and helps migrate existing columns to it.
+//
+// Without this package, RUT fields are stored through their sql.Scanner and
+// driver.Valuer methods as FormatComplete strings. A serializer picks
+// another format per field:
+//
+//	type Invoice struct {
+//		ID     uint
+//		Emisor rut.RUT `gorm:"serializer:rut_dash;size:10"` // "60803000-K"
+//	}
+package rutgorm
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/jestays/rut-go"
+	"gorm.io/gorm/schema"
+)
+
+func init() {
+	schema.RegisterSerializer("rut", Serializer{Style: rut.FormatComplete})
+	schema.RegisterSerializer("rut_dash", Serializer{Style: rut.FormatWithDash})
+	schema.RegisterSerializer("rut_escaped", Serializer{Style: rut.FormatEscaped})
+	schema.RegisterSerializer("rut_number", Serializer{Style: rut.FormatNumberOnly})
+}
+
+// Serializer is a GORM serializer storing RUT and *RUT fields formatted in
+// Style. FormatNumberOnly stores the number alone in an integer column,
+// and the check digit is computed when scanning. Styles without a check
+// digit other than FormatNumberOnly are not supported.
+//
+// The serializers "rut", "rut_dash", "rut_escaped" and "rut_number" are
+// registered for FormatComplete, FormatWithDash, FormatEscaped and
+// FormatNumberOnly. Register others with schema.RegisterSerializer.
+type Serializer struct {
+	Style rut.FormatStyle
+}
+
+// Scan implements schema.SerializerInterface. Values in any format accepted
+// by rut.Parse are scanned, so columns can be migrated gradually.
+func (s Serializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue any) error {
+	fieldValue := reflect.New(field.FieldType)
+	if dbValue != nil {
+		r, err := s.scan(dbValue)
+		if err != nil {
+			return err
+		}
+		if field.FieldType.Kind() == reflect.Pointer {
+			fieldValue.Elem().Set(reflect.ValueOf(&r))
+		} else {
+			fieldValue.Elem().Set(reflect.ValueOf(r))
+		}
+	}
+	field.ReflectValueOf(ctx, dst).Set(fieldValue.Elem())
+	return nil
+}
+
+func (s Serializer) scan(dbValue any) (rut.RUT, error) {
+	var r rut.RUT
+	if s.Style == rut.FormatNumberOnly {
+		// A number without check digit, unlike what rut.Parse expects
+		var text string
+		switch v := dbValue.(type) {
+		case string:
+			text = v
+		case []byte:
+			text = string(v)
+		}
+		if text != "" {
+			n, err := strconv.ParseInt(text, 10, 64)
+			if err != nil {
+				return r, rut.ErrInvalidFormat
+			}
+			dbValue = n
+		}
+	}
+	err := r.Scan(dbValue)
+	return r, err
+}
+
+// Value implements schema.SerializerValuerInterface, storing NULL for a
+// zero RUT or nil pointer. A RUT with a wrong check digit is rejected with
+// rut.ErrInvalidDV.
+func (s Serializer) Value(ctx context.Context, field *schema.Field, dst reflect.Value, fieldValue any) (any, error) {
+	var r rut.RUT
+	switch v := fieldValue.(type) {
+	case rut.RUT:
+		r = v
+	case *rut.RUT:
+		if v != nil {
+			r = *v
+		}
+	case nil:
+	default:
+		return nil, fmt.Errorf("rutgorm: cannot serialize %T", fieldValue)
+	}
+
+	switch {
+	case r == rut.RUT{}:
+		return nil, nil
+	case !r.Validate():
+		return nil, rut.ErrInvalidDV
+	case s.Style == rut.FormatNumberOnly:
+		return int64(r.Number), nil
+	case s.Style == rut.FormatNumberDots || s.Style == rut.FormatPreserve:
+		return nil, fmt.Errorf("rutgorm: unsupported column style %d", s.Style)
+	}
+	return r.Format(s.Style), nil
+}
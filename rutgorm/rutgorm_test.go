@@ -0,0 +1,105 @@
+package rutgorm
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"github.com/jestays/rut-go"
+	"gorm.io/gorm"
+)
+
+type invoice struct {
+	ID       uint
+	Emisor   rut.RUT  `gorm:"serializer:rut_dash"`
+	Receptor *rut.RUT `gorm:"serializer:rut_number"`
+	Contact  rut.RUT
+}
+
+func openDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("gorm.Open() error = %v", err)
+	}
+	return db
+}
+
+func TestSerializer(t *testing.T) {
+	db := openDB(t)
+	if err := db.AutoMigrate(&invoice{}); err != nil {
+		t.Fatalf("AutoMigrate() error = %v", err)
+	}
+
+	receptor := rut.MustParse("12.345.678-5")
+	want := invoice{
+		Emisor:   rut.MustParse("60.803.000-K"),
+		Receptor: &receptor,
+		Contact:  rut.MustParse("1.009-K"),
+	}
+	if err := db.Create(&want).Error; err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if err := db.Create(&invoice{Emisor: want.Emisor}).Error; err != nil {
+		t.Fatalf("Create(nil receptor) error = %v", err)
+	}
+
+	var raw struct {
+		Emisor   string
+		Receptor int64
+		Contact  string
+	}
+	db.Table("invoices").Where("id = ?", want.ID).Take(&raw)
+	if raw.Emisor != "60803000-K" || raw.Receptor != 12345678 || raw.Contact != "1.009-K" {
+		t.Errorf("stored row = %+v; want {60803000-K 12345678 1.009-K}", raw)
+	}
+
+	var got []invoice
+	if err := db.Order("id").Find(&got).Error; err != nil {
+		t.Fatalf("Find() error = %v", err)
+	}
+	if len(got) != 2 || got[0].Emisor != want.Emisor || *got[0].Receptor != receptor || got[0].Contact != want.Contact {
+		t.Errorf("Find() = %+v; want %+v", got, want)
+	}
+	if len(got) == 2 && got[1].Receptor != nil {
+		t.Errorf("Find() receptor = %v; want nil", got[1].Receptor)
+	}
+}
+
+func TestSerializer_InvalidDV(t *testing.T) {
+	db := openDB(t)
+	if err := db.AutoMigrate(&invoice{}); err != nil {
+		t.Fatalf("AutoMigrate() error = %v", err)
+	}
+	bad := rut.RUT{Number: 12345678, DV: '0'}
+	for _, inv := range []invoice{{Emisor: bad}, {Receptor: &bad}} {
+		if err := db.Create(&inv).Error; !errors.Is(err, rut.ErrInvalidDV) {
+			t.Errorf("Create(%+v) error = %v; want %v", inv, err, rut.ErrInvalidDV)
+		}
+	}
+}
+
+func TestNormalizeColumn(t *testing.T) {
+	db := openDB(t)
+	db.Exec("CREATE TABLE customers (id integer primary key, rut text)")
+	for _, v := range []string{"12.345.678-5", "123456785", "60803000-K", "60.803.000-k", "1234", "", "12345678-0"} {
+		db.Exec("INSERT INTO customers (rut) VALUES (?)", v)
+	}
+
+	res, err := NormalizeColumn(db, "customers", "rut", rut.FormatWithDash)
+	if err != nil {
+		t.Fatalf("NormalizeColumn() error = %v", err)
+	}
+	slices.Sort(res.Invalid)
+	if res.Updated != 3 || !slices.Equal(res.Invalid, []string{"1234", "12345678-0"}) {
+		t.Errorf("NormalizeColumn() = %+v; want 3 updated, invalid [1234 12345678-0]", res)
+	}
+
+	var values []string
+	db.Table("customers").Order("id").Pluck("rut", &values)
+	want := []string{"12345678-5", "12345678-5", "60803000-K", "60803000-K", "1234", "", "12345678-0"}
+	if !slices.Equal(values, want) {
+		t.Errorf("column after NormalizeColumn = %q; want %q", values, want)
+	}
+}
@@ -0,0 +1,41 @@
+// Package rutopenapi3 adds a "rut" string format to the schema validation
+// of github.com/getkin/kin-openapi, so request validation middlewares such
+// as openapi3filter reject bad RUTs before they reach a handler:
+//
+//	rutopenapi3.Register()
+//	doc, err := openapi3.NewLoader().LoadFromFile("api.yaml")
+//
+// where the spec declares:
+//
+//	emisor:
+//	  type: string
+//	  format: rut
+package rutopenapi3
+
+import (
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/jestays/rut-go"
+)
+
+// Name is the name of the format.
+const Name = "rut"
+
+// Register defines the rut format in openapi3.SchemaStringFormats, which
+// applies to every document. Call it during initialization, before
+// validating documents or requests, since kin-openapi does not synchronize
+// access to the map. Use Validator with openapi3.WithStringFormatValidator
+// to enable the format for a single validation instead.
+func Register() {
+	openapi3.DefineStringFormatValidator(Name, Validator())
+}
+
+// Validator returns a validator accepting strings in any format supported
+// by rut.Parse with a valid check digit. Its errors are those of
+// rut.ParseStrict, so a wrong check digit is reported as
+// rut.ErrInvalidDV.
+func Validator() openapi3.StringFormatValidator {
+	return openapi3.NewCallbackValidator(func(s string) error {
+		_, err := rut.ParseStrict(s)
+		return err
+	})
+}
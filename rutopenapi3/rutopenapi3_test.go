@@ -0,0 +1,103 @@
+package rutopenapi3
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"github.com/jestays/rut-go"
+)
+
+func TestValidator(t *testing.T) {
+	tests := []struct {
+		input   string
+		wantErr error
+	}{
+		{"12.345.678-5", nil},
+		{"60803000-k", nil},
+		{"12.345.678-0", rut.ErrInvalidDV},
+		{"abc", rut.ErrInvalidFormat},
+		{"", rut.ErrEmptyRUT},
+	}
+
+	v := Validator()
+	for _, tt := range tests {
+		if err := v.Validate(tt.input); !errors.Is(err, tt.wantErr) {
+			t.Errorf("Validate(%q) = %v; want %v", tt.input, err, tt.wantErr)
+		}
+	}
+}
+
+func TestSchema(t *testing.T) {
+	schema := openapi3.NewStringSchema().WithFormat(Name)
+	opt := openapi3.WithStringFormatValidator(Name, Validator())
+
+	if err := schema.VisitJSON("60.803.000-K", opt); err != nil {
+		t.Errorf("VisitJSON(valid) = %v; want nil", err)
+	}
+	if err := schema.VisitJSON("60.803.000-1", opt); err == nil {
+		t.Error("VisitJSON(wrong check digit) = nil; want an error")
+	}
+}
+
+const spec = `
+openapi: 3.0.3
+info: {title: test, version: "1"}
+paths:
+  /contribuyentes:
+    get:
+      parameters:
+        - name: rut
+          in: query
+          required: true
+          schema: {type: string, format: rut}
+      responses:
+        "200": {description: ok}
+`
+
+func TestRegister(t *testing.T) {
+	Register()
+	defer delete(openapi3.SchemaStringFormats, Name)
+
+	ctx := context.Background()
+	doc, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := doc.Validate(ctx, openapi3.EnableSchemaFormatValidation()); err != nil {
+		t.Fatalf("Validate() = %v", err)
+	}
+	router, err := gorillamux.NewRouter(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		query string
+		valid bool
+	}{
+		{"rut=12.345.678-5", true},
+		{"rut=12.345.678-0", false},
+		{"rut=abc", false},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, "/contribuyentes?"+tt.query, nil)
+		route, params, err := router.FindRoute(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = openapi3filter.ValidateRequest(ctx, &openapi3filter.RequestValidationInput{
+			Request:    req,
+			PathParams: params,
+			Route:      route,
+		})
+		if (err == nil) != tt.valid {
+			t.Errorf("ValidateRequest(%s) = %v; want valid %v", tt.query, err, tt.valid)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+// Package rutotel builds OpenTelemetry attributes for RUTs that do not
+// expose the national ID to telemetry backends:
+//
+//	span.SetAttributes(rutotel.Hashed(r, key)) // rut.hash=3f2a...
+//	span.SetAttributes(rutotel.Masked(r))      // rut.masked=**.***.678-5
+//
+// Hashed attributes correlate traces and logs of the same subject across
+// services sharing the key. Masked ones help support staff recognize a
+// subject, but several RUTs share each masked form.
+//
+// TraceReloads and LookupInstitution record spans for the lookups of the
+// rut package: list files reloaded by rut.ListChecker.Watch and searches
+// of the institution registry.
+package rutotel
+
+import (
+	"github.com/jestays/rut-go"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Attribute keys.
+const (
+	HashKey   = attribute.Key("rut.hash")
+	MaskedKey = attribute.Key("rut.masked")
+)
+
+// Hashed returns the rut.hash attribute, holding r.Pseudonym(key). See
+// rut.RUT.Pseudonym for the requirements on key.
+func Hashed(r rut.RUT, key []byte) attribute.KeyValue {
+	return HashKey.String(r.Pseudonym(key))
+}
+
+// Masked returns the rut.masked attribute, holding r.Mask().
+func Masked(r rut.RUT) attribute.KeyValue {
+	return MaskedKey.String(r.Mask())
+}
+
+// Hasher builds hashed attributes with a fixed key and attribute name, for
+// services that configure the key once at startup.
+type Hasher struct {
+	Key       []byte
+	Attribute attribute.Key // HashKey if empty
+}
+
+// Attr returns the hashed attribute for r.
+func (h Hasher) Attr(r rut.RUT) attribute.KeyValue {
+	k := h.Attribute
+	if k == "" {
+		k = HashKey
+	}
+	return k.String(r.Pseudonym(h.Key))
+}
@@ -0,0 +1,40 @@
+package rutotel
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jestays/rut-go"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+var key = []byte("0123456789abcdef0123456789abcdef")
+
+func TestHashed(t *testing.T) {
+	r := rut.MustParse("12.345.678-5")
+	kv := Hashed(r, key)
+	if kv.Key != HashKey || kv.Value.AsString() != r.Pseudonym(key) {
+		t.Errorf("Hashed() = %v; want %s=%s", kv, HashKey, r.Pseudonym(key))
+	}
+	if strings.Contains(kv.Value.AsString(), "12345678") {
+		t.Errorf("Hashed() = %v contains the RUT number", kv)
+	}
+}
+
+func TestMasked(t *testing.T) {
+	kv := Masked(rut.MustParse("12.345.678-5"))
+	if kv.Key != MaskedKey || kv.Value.AsString() != "**.***.678-5" {
+		t.Errorf("Masked() = %v; want %s=**.***.678-5", kv, MaskedKey)
+	}
+}
+
+func TestHasher(t *testing.T) {
+	r := rut.MustParse("1.009-K")
+	if got, want := (Hasher{Key: key}).Attr(r), Hashed(r, key); got != want {
+		t.Errorf("Attr() = %v; want %v", got, want)
+	}
+	h := Hasher{Key: key, Attribute: attribute.Key("enduser.pseudo.id")}
+	if got := h.Attr(r); got.Key != "enduser.pseudo.id" {
+		t.Errorf("Attr() key = %s; want enduser.pseudo.id", got.Key)
+	}
+}
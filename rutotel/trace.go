@@ -0,0 +1,59 @@
+package rutotel
+
+import (
+	"context"
+
+	"github.com/jestays/rut-go"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Span names.
+const (
+	ReloadSpan = "rut.ListChecker.reload"
+	LookupSpan = "rut.LookupInstitution"
+)
+
+// Attribute keys of the spans.
+const (
+	ListPathKey    = attribute.Key("rut.list.path")
+	ListKey        = attribute.Key("rut.list") // "deny" or "allow"
+	ListEntriesKey = attribute.Key("rut.list.entries")
+	FoundKey       = attribute.Key("rut.institution.found")
+)
+
+// TraceReloads makes c record a ReloadSpan for every file reloaded by
+// ListChecker.Watch, as a child of the span in the context passed to
+// Watch. Spans hold the file path, the list and, if the reload succeeded,
+// its number of entries; failed reloads record the error. It replaces any
+// function set with ListChecker.OnReload.
+func TraceReloads(c *rut.ListChecker, tracer trace.Tracer) {
+	c.OnReload(func(ctx context.Context, ev rut.ListReload) {
+		list := "allow"
+		if ev.Deny {
+			list = "deny"
+		}
+		_, span := tracer.Start(ctx, ReloadSpan,
+			trace.WithTimestamp(ev.Start),
+			trace.WithAttributes(ListPathKey.String(ev.Path), ListKey.String(list)))
+		if ev.Err != nil {
+			span.RecordError(ev.Err)
+			span.SetStatus(codes.Error, ev.Err.Error())
+		} else {
+			span.SetAttributes(ListEntriesKey.Int(ev.Entries))
+		}
+		span.End(trace.WithTimestamp(ev.Start.Add(ev.Duration)))
+	})
+}
+
+// LookupInstitution calls rut.LookupInstitution within a LookupSpan, a
+// child of the span in ctx. The span holds the masked RUT and whether it
+// was found.
+func LookupInstitution(ctx context.Context, tracer trace.Tracer, r rut.RUT) (rut.Institution, bool) {
+	_, span := tracer.Start(ctx, LookupSpan, trace.WithAttributes(Masked(r)))
+	defer span.End()
+	in, ok := rut.LookupInstitution(r)
+	span.SetAttributes(FoundKey.Bool(ok))
+	return in, ok
+}
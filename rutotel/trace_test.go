@@ -0,0 +1,151 @@
+package rutotel
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jestays/rut-go"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// recorder is a tracer keeping the spans it starts, since the SDK is not
+// a dependency of this module.
+type recorder struct {
+	noop.Tracer
+	mu    sync.Mutex
+	spans []*span
+}
+
+type span struct {
+	noop.Span
+	name   string
+	parent trace.SpanContext
+	attrs  map[attribute.Key]attribute.Value
+	status codes.Code
+	ended  chan struct{}
+}
+
+func (r *recorder) Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	cfg := trace.NewSpanStartConfig(opts...)
+	s := &span{
+		name:   name,
+		parent: trace.SpanContextFromContext(ctx),
+		attrs:  make(map[attribute.Key]attribute.Value),
+		ended:  make(chan struct{}),
+	}
+	s.SetAttributes(cfg.Attributes()...)
+	r.mu.Lock()
+	r.spans = append(r.spans, s)
+	r.mu.Unlock()
+	return trace.ContextWithSpan(ctx, s), s
+}
+
+func (s *span) SetAttributes(kv ...attribute.KeyValue) {
+	for _, a := range kv {
+		s.attrs[a.Key] = a.Value
+	}
+}
+
+func (s *span) SetStatus(code codes.Code, _ string) { s.status = code }
+
+func (s *span) End(...trace.SpanEndOption) { close(s.ended) }
+
+// parentContext returns a context holding a remote span, to check that
+// spans are started as its children.
+func parentContext() context.Context {
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{1},
+		SpanID:  trace.SpanID{2},
+	})
+	return trace.ContextWithRemoteSpanContext(context.Background(), sc)
+}
+
+func TestTraceReloads(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "deny.txt")
+	if err := os.WriteFile(path, []byte("12.345.678-5\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	c := rut.NewListChecker()
+	if err := c.LoadDenyFile(path); err != nil {
+		t.Fatalf("LoadDenyFile() error = %v", err)
+	}
+
+	tracer := &recorder{}
+	TraceReloads(c, tracer)
+	ctx, cancel := context.WithCancel(parentContext())
+	defer cancel()
+	go c.Watch(ctx, 5*time.Millisecond, nil)
+
+	update := func(content string) *span {
+		t.Helper()
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		later := time.Now().Add(time.Minute)
+		os.Chtimes(path, later, later)
+		for deadline := time.Now().Add(2 * time.Second); time.Now().Before(deadline); {
+			tracer.mu.Lock()
+			var s *span
+			if n := len(tracer.spans); n > 0 {
+				s = tracer.spans[n-1]
+				tracer.spans = nil
+			}
+			tracer.mu.Unlock()
+			if s != nil {
+				<-s.ended
+				return s
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+		t.Fatal("Watch() did not reload the file")
+		return nil
+	}
+
+	s := update("12.345.678-5\n1.009-K\n")
+	if s.name != ReloadSpan || s.parent.TraceID() != (trace.TraceID{1}) {
+		t.Errorf("span = %q with parent %v; want %q under the Watch context", s.name, s.parent, ReloadSpan)
+	}
+	if s.attrs[ListPathKey].AsString() != path || s.attrs[ListKey].AsString() != "deny" ||
+		s.attrs[ListEntriesKey].AsInt64() != 2 || s.status != codes.Unset {
+		t.Errorf("span attributes = %v, status %v; want the deny list with 2 entries", s.attrs, s.status)
+	}
+
+	s = update("not a rut\n")
+	if s.status != codes.Error {
+		t.Errorf("span status = %v; want %v", s.status, codes.Error)
+	}
+	if _, ok := s.attrs[ListEntriesKey]; ok {
+		t.Errorf("span attributes = %v; want no entries for a failed reload", s.attrs)
+	}
+}
+
+func TestLookupInstitution(t *testing.T) {
+	tracer := &recorder{}
+	want, _ := rut.LookupInstitution(rut.MustParse("60.803.000-K"))
+	in, ok := LookupInstitution(parentContext(), tracer, rut.MustParse("60.803.000-K"))
+	if !ok || in != want {
+		t.Errorf("LookupInstitution() = %v, %v; want %v, true", in, ok, want)
+	}
+	if _, ok := LookupInstitution(context.Background(), tracer, rut.MustParse("12.345.678-5")); ok {
+		t.Errorf("LookupInstitution(12.345.678-5) found an institution")
+	}
+
+	if len(tracer.spans) != 2 {
+		t.Fatalf("started %d spans; want 2", len(tracer.spans))
+	}
+	s := tracer.spans[0]
+	if s.name != LookupSpan || s.parent.TraceID() != (trace.TraceID{1}) || !s.attrs[FoundKey].AsBool() ||
+		s.attrs[MaskedKey].AsString() != "**.***.000-K" {
+		t.Errorf("span = %q, parent %v, attributes %v; want a found lookup under the context span", s.name, s.parent, s.attrs)
+	}
+	if s := tracer.spans[1]; s.attrs[FoundKey].AsBool() || s.attrs[MaskedKey].AsString() != "**.***.678-5" {
+		t.Errorf("span attributes = %v; want a masked lookup not found", s.attrs)
+	}
+}
@@ -0,0 +1,124 @@
+// Package rutpgx maps rut.RUT to PostgreSQL with pgx v5, encoding and
+// decoding values without intermediate strings.
+//
+// The codec is meant for a DOMAIN over text, which can also carry the
+// CHECK constraints of the database:
+//
+//	CREATE DOMAIN rut AS text;
+//
+// Register it after connecting, for example in pgxpool.Config.AfterConnect:
+//
+//	err := rutpgx.Register(ctx, conn, "rut", rut.FormatWithDash)
+package rutpgx
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jestays/rut-go"
+)
+
+// Register loads the OID of typeName, usually a DOMAIN over text, and
+// registers a Codec for it on the connection, storing RUTs in style. The
+// type also becomes the default for rut.RUT query arguments.
+func Register(ctx context.Context, conn *pgx.Conn, typeName string, style rut.FormatStyle) error {
+	t, err := conn.LoadType(ctx, typeName)
+	if err != nil {
+		return err
+	}
+	RegisterType(conn.TypeMap(), typeName, t.OID, style)
+	return nil
+}
+
+// RegisterType registers a Codec for the type with the given name and OID
+// on m, for callers that already know the OID.
+func RegisterType(m *pgtype.Map, typeName string, oid uint32, style rut.FormatStyle) {
+	m.RegisterType(&pgtype.Type{Name: typeName, OID: oid, Codec: Codec{Style: style}})
+	m.RegisterDefaultPgType(rut.RUT{}, typeName)
+}
+
+// Codec is a pgtype.Codec for text based columns holding RUTs. It encodes
+// rut.RUT values formatted in Style, and the zero RUT as NULL. It scans any
+// format supported by rut.Parse into *rut.RUT, returning rut.ErrInvalidDV
+// for a wrong check digit. The text and binary formats of text are the
+// same, so both are supported.
+type Codec struct {
+	Style rut.FormatStyle
+}
+
+// FormatSupported implements pgtype.Codec.
+func (Codec) FormatSupported(format int16) bool {
+	return format == pgtype.TextFormatCode || format == pgtype.BinaryFormatCode
+}
+
+// PreferredFormat implements pgtype.Codec.
+func (Codec) PreferredFormat() int16 {
+	return pgtype.BinaryFormatCode
+}
+
+// PlanEncode implements pgtype.Codec.
+func (c Codec) PlanEncode(m *pgtype.Map, oid uint32, format int16, value any) pgtype.EncodePlan {
+	if _, ok := value.(rut.RUT); ok {
+		return encodePlan{style: c.Style}
+	}
+	return nil
+}
+
+// PlanScan implements pgtype.Codec.
+func (Codec) PlanScan(m *pgtype.Map, oid uint32, format int16, target any) pgtype.ScanPlan {
+	if _, ok := target.(*rut.RUT); ok {
+		return scanPlan{}
+	}
+	return nil
+}
+
+// DecodeDatabaseSQLValue implements pgtype.Codec.
+func (Codec) DecodeDatabaseSQLValue(m *pgtype.Map, oid uint32, format int16, src []byte) (driver.Value, error) {
+	if src == nil {
+		return nil, nil
+	}
+	return string(src), nil
+}
+
+// DecodeValue implements pgtype.Codec, returning a rut.RUT or nil.
+func (Codec) DecodeValue(m *pgtype.Map, oid uint32, format int16, src []byte) (any, error) {
+	if src == nil {
+		return nil, nil
+	}
+	var r rut.RUT
+	if err := r.UnmarshalText(src); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+type encodePlan struct {
+	style rut.FormatStyle
+}
+
+func (p encodePlan) Encode(value any, buf []byte) ([]byte, error) {
+	r := value.(rut.RUT)
+	switch {
+	case r == rut.RUT{}:
+		return nil, nil
+	case !r.Validate():
+		return nil, rut.ErrInvalidDV
+	case p.style == rut.FormatNumberOnly || p.style == rut.FormatNumberDots:
+		return nil, fmt.Errorf("rutpgx: unsupported column style %d", p.style)
+	}
+	return r.AppendFormat(buf, p.style), nil
+}
+
+type scanPlan struct{}
+
+func (scanPlan) Scan(src []byte, target any) error {
+	r := target.(*rut.RUT)
+	if src == nil {
+		*r = rut.RUT{}
+		return nil
+	}
+	return r.UnmarshalText(src)
+}
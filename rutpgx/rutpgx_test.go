@@ -0,0 +1,87 @@
+package rutpgx
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jestays/rut-go"
+)
+
+// testOID stands in for the OID of a rut domain
+const testOID = 100_000
+
+func newMap() *pgtype.Map {
+	m := pgtype.NewMap()
+	RegisterType(m, "rut", testOID, rut.FormatWithDash)
+	return m
+}
+
+func TestCodec_Encode(t *testing.T) {
+	m := newMap()
+	tests := []struct {
+		value   any
+		want    []byte
+		wantErr error
+	}{
+		{rut.MustParse("60.803.000-k"), []byte("60803000-K"), nil},
+		{rut.RUT{}, nil, nil},
+		{rut.RUT{Number: 60803000, DV: '1'}, nil, rut.ErrInvalidDV},
+	}
+
+	for _, format := range []int16{pgtype.TextFormatCode, pgtype.BinaryFormatCode} {
+		for _, tt := range tests {
+			got, err := m.Encode(testOID, format, tt.value, nil)
+			if !errors.Is(err, tt.wantErr) || string(got) != string(tt.want) {
+				t.Errorf("Encode(%v, format %d) = %q, %v; want %q, %v", tt.value, format, got, err, tt.want, tt.wantErr)
+			}
+		}
+	}
+}
+
+func TestCodec_Scan(t *testing.T) {
+	m := newMap()
+	tests := []struct {
+		src     []byte
+		want    rut.RUT
+		wantErr error
+	}{
+		{[]byte("12.345.678-5"), rut.MustParse("12345678-5"), nil},
+		{[]byte("60803000k"), rut.MustParse("60803000-K"), nil},
+		{nil, rut.RUT{}, nil},
+		{[]byte("12345678-0"), rut.RUT{}, rut.ErrInvalidDV},
+	}
+
+	for _, tt := range tests {
+		got := rut.MustParse("1.009-K")
+		err := m.Scan(testOID, pgtype.BinaryFormatCode, tt.src, &got)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("Scan(%q) error = %v; want %v", tt.src, err, tt.wantErr)
+			continue
+		}
+		if err == nil && got != tt.want {
+			t.Errorf("Scan(%q) = %v; want %v", tt.src, got, tt.want)
+		}
+	}
+
+	// Values of the domain can also be scanned into strings
+	var s string
+	if err := m.Scan(testOID, pgtype.TextFormatCode, []byte("60803000-K"), &s); err != nil || s != "60803000-K" {
+		t.Errorf("Scan(string) = %q, %v; want %q, nil", s, err, "60803000-K")
+	}
+}
+
+func TestCodec_DecodeValue(t *testing.T) {
+	got, err := Codec{}.DecodeValue(nil, testOID, pgtype.TextFormatCode, []byte("1009-K"))
+	if err != nil || got != rut.MustParse("1.009-K") {
+		t.Errorf("DecodeValue() = %v, %v; want 1.009-K, nil", got, err)
+	}
+}
+
+func TestRegisterType_DefaultType(t *testing.T) {
+	m := newMap()
+	typ, ok := m.TypeForValue(rut.RUT{})
+	if !ok || typ.OID != testOID {
+		t.Errorf("TypeForValue(rut.RUT{}) = %v, %v; want the rut type", typ, ok)
+	}
+}
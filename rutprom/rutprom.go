@@ -0,0 +1,105 @@
+// Package rutprom exports the metrics of RUT validation to Prometheus, so
+// data quality trends are visible per service:
+//
+//	v := rutprom.NewValidator(nil, rutprom.Opts{Namespace: "billing"})
+//	prometheus.MustRegister(v)
+//	r, err := v.Parse(input)
+//
+// The metrics are a counter of results, labeled with rut.ResultValid or
+// the reason of the failure, and a histogram of parse latencies.
+package rutprom
+
+import (
+	"time"
+
+	"github.com/jestays/rut-go"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Opts names the metrics. The zero value exports rut_validations_total and
+// rut_parse_duration_seconds.
+type Opts struct {
+	Namespace   string
+	Subsystem   string
+	ConstLabels prometheus.Labels
+	Buckets     []float64 // Histogram buckets, prometheus.DefBuckets if nil
+}
+
+// Metrics is a rut.Metrics backed by Prometheus collectors. It implements
+// prometheus.Collector, so it can be registered directly, and is safe for
+// concurrent use.
+type Metrics struct {
+	results  *prometheus.CounterVec
+	duration prometheus.Histogram
+}
+
+// NewMetrics returns Metrics named according to opts. Pass it to
+// rut.Instrument, then register it with a prometheus.Registerer.
+func NewMetrics(opts Opts) *Metrics {
+	buckets := opts.Buckets
+	if buckets == nil {
+		buckets = prometheus.DefBuckets
+	}
+
+	m := &Metrics{
+		results: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   opts.Namespace,
+			Subsystem:   opts.Subsystem,
+			Name:        "rut_validations_total",
+			Help:        "RUT validations by result: valid or the reason of the failure.",
+			ConstLabels: opts.ConstLabels,
+		}, []string{"result"}),
+		duration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace:   opts.Namespace,
+			Subsystem:   opts.Subsystem,
+			Name:        "rut_parse_duration_seconds",
+			Help:        "Time spent parsing and validating RUTs.",
+			ConstLabels: opts.ConstLabels,
+			Buckets:     buckets,
+		}),
+	}
+
+	// Export every result from the start, so rates work before the first
+	// failure of each kind
+	for _, result := range rut.Results {
+		m.results.WithLabelValues(result)
+	}
+	return m
+}
+
+// Inc implements rut.Metrics.
+func (m *Metrics) Inc(result string) {
+	m.results.WithLabelValues(result).Inc()
+}
+
+// Observe implements rut.Metrics.
+func (m *Metrics) Observe(d time.Duration) {
+	m.duration.Observe(d.Seconds())
+}
+
+// Describe implements prometheus.Collector.
+func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
+	m.results.Describe(ch)
+	m.duration.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
+	m.results.Collect(ch)
+	m.duration.Collect(ch)
+}
+
+// Validator is a rut.InstrumentedParser reporting to its own Metrics. It
+// implements prometheus.Collector and is safe for concurrent use.
+type Validator struct {
+	*rut.InstrumentedParser
+	*Metrics
+}
+
+// NewValidator returns a Validator wrapping p, or a parser with the default
+// rules if p is nil. Register it with a prometheus.Registerer to export its
+// metrics.
+func NewValidator(p *rut.Parser, opts Opts) *Validator {
+	m := NewMetrics(opts)
+	return &Validator{InstrumentedParser: rut.Instrument(p, m), Metrics: m}
+}
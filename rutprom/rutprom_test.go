@@ -0,0 +1,64 @@
+package rutprom
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/jestays/rut-go"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestValidator(t *testing.T) {
+	v := NewValidator(nil, Opts{Namespace: "billing"})
+	reg := prometheus.NewPedanticRegistry()
+	if err := reg.Register(v); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, input := range []string{"12.345.678-5", "60803000-k", "12.345.678-0", "", "123", "abc"} {
+		v.Parse(input)
+	}
+	if v.Validate("12.345.678-0") {
+		t.Error("Validate(wrong check digit) = true")
+	}
+
+	want := `
+# HELP billing_rut_validations_total RUT validations by result: valid or the reason of the failure.
+# TYPE billing_rut_validations_total counter
+billing_rut_validations_total{result="empty"} 1
+billing_rut_validations_total{result="invalid_dv"} 2
+billing_rut_validations_total{result="invalid_format"} 1
+billing_rut_validations_total{result="other"} 0
+billing_rut_validations_total{result="too_long"} 0
+billing_rut_validations_total{result="too_short"} 1
+billing_rut_validations_total{result="valid"} 2
+`
+	if err := testutil.GatherAndCompare(reg, strings.NewReader(want), "billing_rut_validations_total"); err != nil {
+		t.Error(err)
+	}
+	if got := testutil.CollectAndCount(v, "billing_rut_parse_duration_seconds"); got != 1 {
+		t.Errorf("parse duration series = %d; want 1", got)
+	}
+}
+
+func TestValidator_Parser(t *testing.T) {
+	v := NewValidator(rut.NewParser(rut.WithStyles(rut.FormatWithDash)), Opts{})
+	if _, err := v.Parse("12.345.678-5"); !errors.Is(err, rut.ErrInvalidFormat) {
+		t.Errorf("Parse() error = %v; want %v", err, rut.ErrInvalidFormat)
+	}
+	r, err := v.Parse("12345678-5")
+	if err != nil || r != rut.MustParse("12.345.678-5") {
+		t.Errorf("Parse() = %v, %v; want 12.345.678-5", r, err)
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	m := NewMetrics(Opts{})
+	p := rut.Instrument(rut.NewParser(rut.WithLength(2, 10)), m)
+	p.Validate("1-9")
+	if got := testutil.ToFloat64(m.results.WithLabelValues(rut.ResultValid)); got != 1 {
+		t.Errorf("valid = %v; want 1", got)
+	}
+}
@@ -0,0 +1,59 @@
+package rutqr
+
+import (
+	"bytes"
+	"image/png"
+
+	"github.com/boombuler/barcode"
+	"github.com/boombuler/barcode/code128"
+	"github.com/boombuler/barcode/code39"
+	"github.com/jestays/rut-go"
+)
+
+// Code128 returns the Code 128 barcode of r, encoding rut.Code128Payload,
+// as a PNG image of width by height pixels. The width must fit the
+// barcode modules, at least 90 pixels for a 9 digit RUT.
+func Code128(r rut.RUT, width, height int) ([]byte, error) {
+	if r.IsZero() {
+		return nil, ErrZeroRUT
+	}
+	payload, err := rut.Code128Payload(r)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := code128.Encode(payload)
+	if err != nil {
+		return nil, err
+	}
+	return encodePNG(bc, width, height)
+}
+
+// Code39 returns the Code 39 barcode of r, encoding rut.Code39Payload, as a
+// PNG image of width by height pixels. With checksum, the modulo 43 check
+// character is included.
+func Code39(r rut.RUT, width, height int, checksum bool) ([]byte, error) {
+	if r.IsZero() {
+		return nil, ErrZeroRUT
+	}
+	payload, err := rut.Code39Payload(r, checksum)
+	if err != nil {
+		return nil, err
+	}
+	bc, err := code39.Encode(payload, false, false)
+	if err != nil {
+		return nil, err
+	}
+	return encodePNG(bc, width, height)
+}
+
+func encodePNG(bc barcode.Barcode, width, height int) ([]byte, error) {
+	scaled, err := barcode.Scale(bc, width, height)
+	if err != nil {
+		return nil, err
+	}
+	var b bytes.Buffer
+	if err := png.Encode(&b, scaled); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
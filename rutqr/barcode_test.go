@@ -0,0 +1,58 @@
+package rutqr
+
+import (
+	"bytes"
+	"errors"
+	"image/png"
+	"testing"
+
+	"github.com/jestays/rut-go"
+)
+
+func TestBarcodes(t *testing.T) {
+	k := rut.RUT{Number: 1009, DV: 'k'}
+	tests := []struct {
+		name   string
+		render func() ([]byte, error)
+	}{
+		{"Code128", func() ([]byte, error) { return Code128(testRUT, 300, 60) }},
+		{"Code128 K", func() ([]byte, error) { return Code128(k, 300, 60) }},
+		{"Code39", func() ([]byte, error) { return Code39(testRUT, 300, 60, false) }},
+		{"Code39 checksum", func() ([]byte, error) { return Code39(k, 300, 60, true) }},
+	}
+
+	for _, tt := range tests {
+		b, err := tt.render()
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		img, err := png.Decode(bytes.NewReader(b))
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if got := img.Bounds(); got.Dx() != 300 || got.Dy() != 60 {
+			t.Errorf("%s size = %d x %d; want 300 x 60", tt.name, got.Dx(), got.Dy())
+		}
+	}
+}
+
+func TestBarcodes_Errors(t *testing.T) {
+	if _, err := Code128(rut.RUT{}, 300, 60); err != ErrZeroRUT {
+		t.Errorf("Code128(zero) = %v; want ErrZeroRUT", err)
+	}
+	if _, err := Code39(rut.RUT{}, 300, 60, false); err != ErrZeroRUT {
+		t.Errorf("Code39(zero) = %v; want ErrZeroRUT", err)
+	}
+	bad := rut.RUT{Number: 12_345_678, DV: '4'}
+	if _, err := Code128(bad, 300, 60); !errors.Is(err, rut.ErrInvalidDV) {
+		t.Errorf("Code128(%v) = %v; want ErrInvalidDV", bad, err)
+	}
+	if _, err := Code39(bad, 300, 60, true); !errors.Is(err, rut.ErrInvalidDV) {
+		t.Errorf("Code39(%v) = %v; want ErrInvalidDV", bad, err)
+	}
+	if _, err := Code39(testRUT, 10, 60, false); err == nil {
+		t.Error("Code39() narrower than the barcode = nil; want error")
+	}
+}
@@ -0,0 +1,11 @@
+module github.com/jestays/rut-go/rutqr
+
+go 1.21
+
+require (
+	github.com/boombuler/barcode v1.1.0
+	github.com/jestays/rut-go v0.0.0
+	github.com/skip2/go-qrcode v0.0.0-20200617195104-da1b6568686e
+)
+
+replace github.com/jestays/rut-go => ../
@@ -0,0 +1,93 @@
+// Package rutqr renders RUTs as QR codes and Code 128 or Code 39 barcodes,
+// for printed credentials, event badges and warehouse labels:
+//
+//	png, err := rutqr.PNG(r, 256, rutqr.Options{})
+//	svg, err := rutqr.SVG(r, 256, rutqr.Options{Template: `https://eventos.example.cl/a/{{rutFormat . "escaped"}}`})
+//
+// The payload is the RUT in the chosen style, or the result of a
+// text/template executed with the RUT and the functions of
+// rut.TemplateFuncs.
+package rutqr
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/jestays/rut-go"
+	"github.com/skip2/go-qrcode"
+)
+
+// ErrZeroRUT is returned when rendering the zero RUT.
+var ErrZeroRUT = errors.New("rutqr: zero RUT")
+
+// Options configures the payload and error correction of a QR code. The
+// zero value encodes the RUT in rut.FormatComplete style with the lowest
+// error correction, which keeps the code small.
+type Options struct {
+	Style    rut.FormatStyle      // Style of the payload without Template
+	Template string               // text/template producing the payload, with the RUT as dot
+	Level    qrcode.RecoveryLevel // Error correction level
+}
+
+// Payload returns the text encoded in the QR code of r.
+func Payload(r rut.RUT, opts Options) (string, error) {
+	if r.IsZero() {
+		return "", ErrZeroRUT
+	}
+	if opts.Template == "" {
+		return r.Format(opts.Style), nil
+	}
+	t, err := template.New("rutqr").Funcs(rut.TemplateFuncs()).Parse(opts.Template)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	if err := t.Execute(&b, r); err != nil {
+		return "", err
+	}
+	return b.String(), nil
+}
+
+// PNG returns the QR code of r as a PNG image of size by size pixels.
+func PNG(r rut.RUT, size int, opts Options) ([]byte, error) {
+	q, err := newQR(r, opts)
+	if err != nil {
+		return nil, err
+	}
+	return q.PNG(size)
+}
+
+// SVG returns the QR code of r as an SVG image of size by size pixels.
+// Vector output stays sharp at any print resolution.
+func SVG(r rut.RUT, size int, opts Options) ([]byte, error) {
+	q, err := newQR(r, opts)
+	if err != nil {
+		return nil, err
+	}
+	bitmap := q.Bitmap()
+	n := len(bitmap)
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" shape-rendering="crispEdges">`, size, size, n, n)
+	fmt.Fprintf(&b, `<rect width="%d" height="%d" fill="#fff"/><path fill="#000" d="`, n, n)
+	for y, row := range bitmap {
+		for x, dark := range row {
+			if dark {
+				fmt.Fprintf(&b, "M%d %dh1v1h-1z", x, y)
+			}
+		}
+	}
+	b.WriteString(`"/></svg>`)
+	return b.Bytes(), nil
+}
+
+func newQR(r rut.RUT, opts Options) (*qrcode.QRCode, error) {
+	payload, err := Payload(r, opts)
+	if err != nil {
+		return nil, err
+	}
+	return qrcode.New(payload, opts.Level)
+}
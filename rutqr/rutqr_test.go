@@ -0,0 +1,88 @@
+package rutqr
+
+import (
+	"bytes"
+	"encoding/xml"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/jestays/rut-go"
+)
+
+var testRUT = rut.RUT{Number: 12_345_678, DV: '5'}
+
+func TestPayload(t *testing.T) {
+	tests := []struct {
+		opts Options
+		want string
+	}{
+		{Options{}, "12.345.678-5"},
+		{Options{Style: rut.FormatEscaped}, "123456785"},
+		{Options{Template: `https://eventos.example.cl/a/{{rutFormat . "escaped"}}`}, "https://eventos.example.cl/a/123456785"},
+		{Options{Template: `RUT:{{.Number}};DV:{{printf "%c" .DV}}`}, "RUT:12345678;DV:5"},
+	}
+
+	for _, tt := range tests {
+		got, err := Payload(testRUT, tt.opts)
+		if err != nil || got != tt.want {
+			t.Errorf("Payload(%+v) = %q, %v; want %q, nil", tt.opts, got, err, tt.want)
+		}
+	}
+}
+
+func TestPayload_Errors(t *testing.T) {
+	if _, err := Payload(rut.RUT{}, Options{}); err != ErrZeroRUT {
+		t.Errorf("Payload(zero) = %v; want ErrZeroRUT", err)
+	}
+	if _, err := Payload(testRUT, Options{Template: "{{"}); err == nil {
+		t.Error("Payload() with a bad template = nil; want error")
+	}
+}
+
+func TestPNG(t *testing.T) {
+	b, err := PNG(testRUT, 128, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := png.Decode(bytes.NewReader(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := img.Bounds().Dx(); got != 128 {
+		t.Errorf("PNG width = %d; want 128", got)
+	}
+}
+
+func TestSVG(t *testing.T) {
+	b, err := SVG(testRUT, 200, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var svg struct {
+		Width  string `xml:"width,attr"`
+		Height string `xml:"height,attr"`
+		Path   struct {
+			D string `xml:"d,attr"`
+		} `xml:"path"`
+	}
+	if err := xml.Unmarshal(b, &svg); err != nil {
+		t.Fatalf("SVG is not valid XML: %v", err)
+	}
+	if svg.Width != "200" || svg.Height != "200" {
+		t.Errorf("SVG size = %s x %s; want 200 x 200", svg.Width, svg.Height)
+	}
+
+	q, _ := newQR(testRUT, Options{})
+	dark := 0
+	for _, row := range q.Bitmap() {
+		for _, v := range row {
+			if v {
+				dark++
+			}
+		}
+	}
+	if got := strings.Count(svg.Path.D, "M"); got != dark {
+		t.Errorf("SVG has %d modules; want %d", got, dark)
+	}
+}
@@ -0,0 +1,19 @@
+module github.com/jestays/rut-go/rutstrfmt
+
+go 1.26.0
+
+require (
+	github.com/go-openapi/strfmt v0.27.2
+	github.com/go-viper/mapstructure/v2 v2.5.0
+	github.com/jestays/rut-go v0.0.0
+)
+
+require (
+	github.com/go-openapi/errors v0.22.8 // indirect
+	github.com/google/uuid v1.6.0 // indirect
+	github.com/oklog/ulid/v2 v2.1.2 // indirect
+	golang.org/x/net v0.58.0 // indirect
+	golang.org/x/text v0.41.0 // indirect
+)
+
+replace github.com/jestays/rut-go => ../
@@ -0,0 +1,38 @@
+// Package rutstrfmt registers a "rut" string format with
+// github.com/go-openapi/strfmt, so servers and clients generated by
+// go-swagger validate RUT parameters and fields. Importing the package
+// registers the format in strfmt.Default:
+//
+//	import _ "github.com/jestays/rut-go/rutstrfmt"
+//
+// In the spec, declare the format and map it to rut.RUT:
+//
+//	emisor:
+//	  type: string
+//	  format: rut
+//	  x-go-type:
+//	    type: RUT
+//	    import:
+//	      package: github.com/jestays/rut-go
+package rutstrfmt
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/jestays/rut-go"
+)
+
+// Name is the name of the format.
+const Name = "rut"
+
+func init() {
+	Register(strfmt.Default)
+}
+
+// Register adds the rut format to registry, for registries other than
+// strfmt.Default. Values are accepted in any format supported by rut.Parse
+// and must have a valid check digit. rut.RUT implements strfmt.Format, so
+// Parse returns a *rut.RUT and the mapstructure hook of the registry
+// decodes into rut.RUT fields.
+func Register(registry strfmt.Registry) {
+	registry.Add(Name, new(rut.RUT), rut.Validate)
+}
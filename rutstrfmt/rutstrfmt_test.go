@@ -0,0 +1,72 @@
+package rutstrfmt
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/jestays/rut-go"
+)
+
+func TestValidates(t *testing.T) {
+	tests := []struct {
+		input string
+		want  bool
+	}{
+		{"12.345.678-5", true},
+		{"60803000-k", true},
+		{"12.345.678-0", false},
+		{"abc", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := strfmt.Default.Validates(Name, tt.input); got != tt.want {
+			t.Errorf("Validates(%q, %q) = %v; want %v", Name, tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	v, err := strfmt.Default.Parse(Name, "60803000-k")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if got, ok := v.(*rut.RUT); !ok || *got != rut.MustParse("60.803.000-K") {
+		t.Errorf("Parse() = %#v; want 60.803.000-K", v)
+	}
+
+	if _, err := strfmt.Default.Parse(Name, "60803000-1"); err == nil {
+		t.Error("Parse(wrong check digit) error = nil; want an error")
+	}
+}
+
+func TestRegister(t *testing.T) {
+	registry := strfmt.NewSeededFormats(nil, nil)
+	if registry.ContainsName(Name) {
+		t.Fatal("empty registry contains rut")
+	}
+	Register(registry)
+	if !registry.Validates(Name, "12.345.678-5") {
+		t.Error("Validates() = false after Register")
+	}
+}
+
+func TestMapStructureHook(t *testing.T) {
+	var got struct {
+		Emisor rut.RUT
+	}
+	dec, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook: strfmt.Default.MapStructureHookFunc(),
+		Result:     &got,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := dec.Decode(map[string]any{"Emisor": "60803000-k"}); err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if want := rut.MustParse("60.803.000-K"); got.Emisor != want {
+		t.Errorf("Decode() = %v; want %v", got.Emisor, want)
+	}
+}
@@ -0,0 +1,100 @@
+// Package ruttestdata embeds a curated table of valid reference RUTs, so
+// tests do not hardcode samples that may be mistyped:
+//
+//	for _, e := range ruttestdata.ByCategory(ruttestdata.Institution) {
+//		if !myValidator(e.RUT.String()) {
+//			t.Errorf("rejected %v, %s", e.RUT, e.Note)
+//		}
+//	}
+//
+// The table holds institutional RUTs, the placeholders defined by the SII,
+// examples common in documentation and computed boundary values. Every
+// check digit is verified when the table is loaded.
+package ruttestdata
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/jestays/rut-go"
+)
+
+//go:embed reference.csv
+var referenceCSV string
+
+// Category groups the entries of the table.
+type Category string
+
+// Categories of the entries.
+const (
+	Institution Category = "institution" // Public institutions, see rut.Institutions
+	Reserved    Category = "reserved"    // Placeholders defined by the SII, see rut.RUT.IsReserved
+	Example     Category = "example"     // Made up numbers common in documentation, see rut.RUT.IsFictitious
+	Boundary    Category = "boundary"    // Edges of the number ranges and accepted lengths
+)
+
+// Entry is a reference RUT.
+type Entry struct {
+	RUT      rut.RUT
+	Category Category
+	Note     string // What the RUT is, such as "Banco Central de Chile"
+}
+
+var entries = sync.OnceValue(func() []Entry {
+	cr := csv.NewReader(strings.NewReader(referenceCSV))
+	cr.Comment = '#'
+	cr.FieldsPerRecord = 3
+	records, err := cr.ReadAll()
+	if err != nil {
+		panic("ruttestdata: " + err.Error())
+	}
+	list := make([]Entry, 0, len(records))
+	for _, rec := range records {
+		r, err := rut.ParseStrict(rec[0])
+		if err != nil {
+			panic(fmt.Sprintf("ruttestdata: %s: %v", rec[0], err))
+		}
+		list = append(list, Entry{RUT: r, Category: Category(rec[1]), Note: rec[2]})
+	}
+	return list
+})
+
+// All returns every entry of the table, in the order of reference.csv.
+func All() []Entry {
+	return slices.Clone(entries())
+}
+
+// ByCategory returns the entries of category c.
+func ByCategory(c Category) []Entry {
+	var list []Entry
+	for _, e := range entries() {
+		if e.Category == c {
+			list = append(list, e)
+		}
+	}
+	return list
+}
+
+// Lookup returns the entry of r, if it is in the table.
+func Lookup(r rut.RUT) (Entry, bool) {
+	for _, e := range entries() {
+		if e.RUT.Number == r.Number {
+			return e, true
+		}
+	}
+	return Entry{}, false
+}
+
+// Strings returns the RUTs of every entry formatted in style, for table
+// driven tests of code that takes strings.
+func Strings(style rut.FormatStyle) []string {
+	list := make([]string, 0, len(entries()))
+	for _, e := range entries() {
+		list = append(list, e.RUT.Format(style))
+	}
+	return list
+}
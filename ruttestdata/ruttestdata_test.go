@@ -0,0 +1,98 @@
+package ruttestdata
+
+import (
+	"testing"
+
+	"github.com/jestays/rut-go"
+)
+
+func TestAll(t *testing.T) {
+	seen := make(map[int]bool)
+	for _, e := range All() {
+		if !e.RUT.Validate() {
+			t.Errorf("%v: invalid check digit", e.RUT)
+		}
+		if e.Note == "" {
+			t.Errorf("%v: missing note", e.RUT)
+		}
+		if seen[e.RUT.Number] {
+			t.Errorf("%v: duplicated", e.RUT)
+		}
+		seen[e.RUT.Number] = true
+	}
+}
+
+func TestByCategory(t *testing.T) {
+	tests := []struct {
+		c     Category
+		check func(rut.RUT) bool
+	}{
+		{Institution, func(r rut.RUT) bool { _, ok := rut.LookupInstitution(r); return ok }},
+		{Reserved, rut.RUT.IsReserved},
+		{Example, rut.RUT.IsFictitious},
+		{Boundary, func(r rut.RUT) bool { return r.Kind() != rut.KindUnknown }},
+	}
+
+	total := 0
+	for _, tt := range tests {
+		list := ByCategory(tt.c)
+		if len(list) == 0 {
+			t.Errorf("ByCategory(%q) is empty", tt.c)
+		}
+		for _, e := range list {
+			if e.Category != tt.c || !tt.check(e.RUT) {
+				t.Errorf("ByCategory(%q) has %v, %s", tt.c, e.RUT, e.Category)
+			}
+		}
+		total += len(list)
+	}
+	if total != len(All()) {
+		t.Errorf("categories cover %d entries; want %d", total, len(All()))
+	}
+}
+
+func TestInstitutions(t *testing.T) {
+	for _, in := range rut.Institutions() {
+		if e, ok := Lookup(in.RUT); !ok || e.Category != Institution {
+			t.Errorf("Lookup(%v) = %+v, %v; want the institution %s", in.RUT, e, ok, in.ShortName)
+		}
+	}
+	if got, want := len(ByCategory(Institution)), len(rut.Institutions()); got != want {
+		t.Errorf("len(ByCategory(Institution)) = %d; want %d", got, want)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	e, ok := Lookup(rut.FinalConsumer)
+	if !ok || e.Category != Reserved {
+		t.Errorf("Lookup(%v) = %+v, %v; want a reserved entry", rut.FinalConsumer, e, ok)
+	}
+	if _, ok := Lookup(rut.RUT{Number: 18_765_432, DV: '7'}); ok {
+		t.Errorf("Lookup(18.765.432-7) = true; want false")
+	}
+}
+
+func TestStrings(t *testing.T) {
+	for _, style := range []rut.FormatStyle{rut.FormatComplete, rut.FormatEscaped, rut.FormatWithDash} {
+		list := Strings(style)
+		if len(list) != len(All()) {
+			t.Fatalf("len(Strings(%v)) = %d; want %d", style, len(list), len(All()))
+		}
+		for _, s := range list {
+			if !rut.Validate(s) {
+				t.Errorf("Strings(%v) has %q; want valid RUTs", style, s)
+			}
+		}
+	}
+}
+
+func TestBoundaries(t *testing.T) {
+	for _, e := range ByCategory(Boundary) {
+		if _, err := rut.ParseStrict(e.RUT.Format(rut.FormatEscaped)); err != nil {
+			t.Errorf("ParseStrict(%q) = %v; want nil", e.RUT.Format(rut.FormatEscaped), err)
+		}
+	}
+	if _, err := rut.Parse("1.000.000.000-0"); err == nil {
+		t.Error(`Parse("1.000.000.000-0") = nil; want error above the highest boundary`)
+	}
+}
@@ -0,0 +1,113 @@
+// Package rutxlsx validates and normalizes a column of RUTs in Excel
+// workbooks with excelize, leaving styles and every other cell untouched:
+//
+//	f, err := excelize.OpenFile("clientes.xlsx")
+//	...
+//	res, err := rutxlsx.ValidateColumn(f, rutxlsx.Options{Column: "C", HeaderRows: 1, Normalize: true})
+//	for _, c := range res.Invalid {
+//		fmt.Println(c.Ref, c.Value, c.Err)
+//	}
+//	err = f.Save()
+package rutxlsx
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/jestays/rut-go"
+	"github.com/xuri/excelize/v2"
+)
+
+// ErrNoColumn is returned by ValidateColumn when Options.Column is empty.
+var ErrNoColumn = errors.New("rutxlsx: no column")
+
+// Options configures ValidateColumn.
+type Options struct {
+	Sheet      string          // Sheet name, "" for the first sheet
+	Column     string          // Column name, such as "C"
+	HeaderRows int             // Rows to skip at the top of the sheet
+	Normalize  bool            // Rewrite valid cells in Style, as text
+	Style      rut.FormatStyle // Style used by Normalize
+}
+
+// Cell is an invalid cell found by ValidateColumn.
+type Cell struct {
+	Ref   string // Cell reference, such as "C7"
+	Value string
+	Err   error
+}
+
+// Result reports what ValidateColumn found.
+type Result struct {
+	Rows       int // Rows checked, up to the last one holding a value
+	Empty      int // Blank cells
+	Valid      int // Cells holding a valid RUT
+	Normalized int // Valid cells rewritten by Normalize
+	Invalid    []Cell
+}
+
+// ValidateColumn checks every cell of a column below the header rows, in
+// the order of the rows. Cells holding numbers, as Excel stores RUTs typed
+// without a dash, are read as their digits. Values are parsed with
+// rut.ParseStrict, so a wrong check digit makes a cell invalid.
+//
+// With Normalize, valid cells not already written in Style are replaced by
+// text in that style. The cell style is kept, and invalid and blank cells
+// are never modified. Save the file to keep the changes.
+func ValidateColumn(f *excelize.File, opts Options) (Result, error) {
+	var res Result
+	if opts.Column == "" {
+		return res, ErrNoColumn
+	}
+	col, err := excelize.ColumnNameToNumber(opts.Column)
+	if err != nil {
+		return res, err
+	}
+	sheet := opts.Sheet
+	if sheet == "" {
+		sheet = f.GetSheetName(0)
+	}
+
+	cols, err := f.Cols(sheet)
+	if err != nil {
+		return res, err
+	}
+	var values []string
+	for i := 1; cols.Next(); i++ {
+		if i == col {
+			values, err = cols.Rows(excelize.Options{RawCellValue: true})
+			if err != nil {
+				return res, err
+			}
+			break
+		}
+	}
+
+	for row := opts.HeaderRows + 1; row <= len(values); row++ {
+		res.Rows++
+		v := strings.TrimSpace(values[row-1])
+		if v == "" {
+			res.Empty++
+			continue
+		}
+
+		ref, err := excelize.CoordinatesToCellName(col, row)
+		if err != nil {
+			return res, err
+		}
+		r, err := rut.ParseStrict(v)
+		if err != nil {
+			res.Invalid = append(res.Invalid, Cell{Ref: ref, Value: values[row-1], Err: err})
+			continue
+		}
+		res.Valid++
+
+		if s := r.Format(opts.Style); opts.Normalize && s != values[row-1] {
+			if err := f.SetCellStr(sheet, ref, s); err != nil {
+				return res, err
+			}
+			res.Normalized++
+		}
+	}
+	return res, nil
+}
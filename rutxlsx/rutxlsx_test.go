@@ -0,0 +1,108 @@
+package rutxlsx
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/jestays/rut-go"
+	"github.com/xuri/excelize/v2"
+)
+
+func newWorkbook(t *testing.T) *excelize.File {
+	t.Helper()
+	f := excelize.NewFile()
+	t.Cleanup(func() { f.Close() })
+	rows := [][]any{
+		{"nombre", "rut"},
+		{"Ana", "12345678-5"},
+		{"Luis", 1009},
+		{"Eva", "12.345.678-0"},
+		{"Juan", nil},
+		{"Rosa", "60.803.000-K"},
+		{"Pedro", 600},
+	}
+	for i, row := range rows {
+		cell, _ := excelize.CoordinatesToCellName(1, i+1)
+		if err := f.SetSheetRow("Sheet1", cell, &row); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return f
+}
+
+func TestValidateColumn(t *testing.T) {
+	f := newWorkbook(t)
+	res, err := ValidateColumn(f, Options{Column: "B", HeaderRows: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Result{
+		Rows:  6,
+		Empty: 1,
+		Valid: 2,
+		Invalid: []Cell{
+			{Ref: "B3", Value: "1009", Err: rut.ErrTooShort},
+			{Ref: "B4", Value: "12.345.678-0", Err: rut.ErrInvalidDV},
+			{Ref: "B7", Value: "600", Err: rut.ErrTooShort},
+		},
+	}
+	if res.Rows != want.Rows || res.Empty != want.Empty || res.Valid != want.Valid || res.Normalized != 0 {
+		t.Errorf("ValidateColumn() = %+v; want %+v", res, want)
+	}
+	if len(res.Invalid) != len(want.Invalid) {
+		t.Fatalf("ValidateColumn() Invalid = %v; want %v", res.Invalid, want.Invalid)
+	}
+	for i, c := range res.Invalid {
+		w := want.Invalid[i]
+		if c.Ref != w.Ref || c.Value != w.Value || !errors.Is(c.Err, w.Err) {
+			t.Errorf("Invalid[%d] = %+v; want %+v", i, c, w)
+		}
+	}
+}
+
+func TestValidateColumn_Normalize(t *testing.T) {
+	f := newWorkbook(t)
+	style, err := f.NewStyle(&excelize.Style{Font: &excelize.Font{Bold: true}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.SetCellStyle("Sheet1", "B2", "B2", style); err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := ValidateColumn(f, Options{Column: "B", HeaderRows: 1, Normalize: true, Style: rut.FormatComplete})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Normalized != 1 {
+		t.Errorf("Normalized = %d; want 1", res.Normalized)
+	}
+
+	var got []string
+	for row := 1; row <= 7; row++ {
+		v, err := f.GetCellValue("Sheet1", fmt.Sprintf("B%d", row))
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, v)
+	}
+	want := []string{"rut", "12.345.678-5", "1009", "12.345.678-0", "", "60.803.000-K", "600"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("column B = %q; want %q", got, want)
+	}
+	if s, _ := f.GetCellStyle("Sheet1", "B2"); s != style {
+		t.Errorf("style of B2 = %d; want %d", s, style)
+	}
+}
+
+func TestValidateColumn_Errors(t *testing.T) {
+	f := newWorkbook(t)
+	if _, err := ValidateColumn(f, Options{}); err != ErrNoColumn {
+		t.Errorf("ValidateColumn() without column = %v; want ErrNoColumn", err)
+	}
+	if _, err := ValidateColumn(f, Options{Column: "B", Sheet: "missing"}); err == nil {
+		t.Error("ValidateColumn() of a missing sheet = nil; want error")
+	}
+}
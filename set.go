@@ -0,0 +1,172 @@
+package rut
+
+import (
+	"math/bits"
+	"slices"
+)
+
+// MaxSetNumber is the largest RUT number a RUTSet can hold, the largest
+// number accepted by Parse.
+const MaxSetNumber = 999_999_999
+
+// Set is a set of RUTs keyed by number. RUTSet suits dense sets such as a
+// full registry extract and SparseSet suits allowlists of a few thousand
+// RUTs.
+type Set interface {
+	// Add adds r to the set and reports whether it was not already present.
+	Add(r RUT) bool
+	// Remove removes r from the set and reports whether it was present.
+	Remove(r RUT) bool
+	// Contains reports whether the number of r is in the set.
+	Contains(r RUT) bool
+	// Len returns the number of RUTs in the set.
+	Len() int
+	// Each calls fn for every RUT in increasing order, until fn returns false.
+	Each(fn func(RUT) bool)
+}
+
+var (
+	_ Set = (*RUTSet)(nil)
+	_ Set = (*SparseSet)(nil)
+)
+
+// RUTSet is a set of RUTs backed by a bitset indexed by RUT number. It uses
+// one bit per number up to the largest one stored, about 12.5 MB for
+// numbers below 100.000.000, and gives O(1) membership checks.
+//
+// Check digits are not stored: RUTs are added and looked up by number, and
+// iteration yields RUTs with their computed check digit.
+//
+// The zero value is an empty set ready to use. A RUTSet is safe for
+// concurrent reads but not for concurrent modification.
+type RUTSet struct {
+	bits []uint64
+	n    int
+}
+
+// NewRUTSet returns an empty set with room preallocated for numbers up to
+// maxNumber, avoiding reallocations while it is filled.
+func NewRUTSet(maxNumber int) *RUTSet {
+	s := &RUTSet{}
+	if maxNumber > 0 {
+		s.bits = make([]uint64, 0, wordIndex(min(maxNumber, MaxSetNumber))+1)
+	}
+	return s
+}
+
+func wordIndex(number int) int {
+	return number >> 6
+}
+
+// Add adds r to the set and reports whether it was not already present.
+// Numbers below 1 or above MaxSetNumber are not added.
+func (s *RUTSet) Add(r RUT) bool {
+	if r.Number < 1 || r.Number > MaxSetNumber {
+		return false
+	}
+	w := wordIndex(r.Number)
+	if w >= len(s.bits) {
+		s.grow(w + 1)
+	}
+	mask := uint64(1) << (r.Number & 63)
+	if s.bits[w]&mask != 0 {
+		return false
+	}
+	s.bits[w] |= mask
+	s.n++
+	return true
+}
+
+// Remove removes r from the set and reports whether it was present.
+func (s *RUTSet) Remove(r RUT) bool {
+	if !s.Contains(r) {
+		return false
+	}
+	s.bits[wordIndex(r.Number)] &^= uint64(1) << (r.Number & 63)
+	s.n--
+	return true
+}
+
+// Contains reports whether the number of r is in the set.
+func (s *RUTSet) Contains(r RUT) bool {
+	if r.Number < 1 {
+		return false
+	}
+	w := wordIndex(r.Number)
+	return w < len(s.bits) && s.bits[w]&(uint64(1)<<(r.Number&63)) != 0
+}
+
+// Len returns the number of RUTs in the set.
+func (s *RUTSet) Len() int {
+	return s.n
+}
+
+// Each calls fn for every RUT in the set in increasing order, until fn
+// returns false.
+func (s *RUTSet) Each(fn func(RUT) bool) {
+	for w, word := range s.bits {
+		for word != 0 {
+			number := w<<6 | bits.TrailingZeros64(word)
+			if !fn(RUT{Number: number, DV: CalculateDV(number)}) {
+				return
+			}
+			word &= word - 1
+		}
+	}
+}
+
+// grow extends the bitset to hold at least n words, doubling the capacity
+// to amortize reallocations.
+func (s *RUTSet) grow(n int) {
+	if n <= cap(s.bits) {
+		s.bits = s.bits[:n]
+		return
+	}
+	words := make([]uint64, n, max(n, 2*cap(s.bits)))
+	copy(words, s.bits)
+	s.bits = words
+}
+
+// Union returns a new set with the RUTs in s or t.
+func (s *RUTSet) Union(t *RUTSet) *RUTSet {
+	return combine(s, t, max(len(s.bits), len(t.bits)), func(a, b uint64) uint64 { return a | b })
+}
+
+// Intersect returns a new set with the RUTs in both s and t.
+func (s *RUTSet) Intersect(t *RUTSet) *RUTSet {
+	return combine(s, t, min(len(s.bits), len(t.bits)), func(a, b uint64) uint64 { return a & b })
+}
+
+// Difference returns a new set with the RUTs in s that are not in t.
+func (s *RUTSet) Difference(t *RUTSet) *RUTSet {
+	return combine(s, t, len(s.bits), func(a, b uint64) uint64 { return a &^ b })
+}
+
+// SymmetricDifference returns a new set with the RUTs in either s or t but
+// not in both.
+func (s *RUTSet) SymmetricDifference(t *RUTSet) *RUTSet {
+	return combine(s, t, max(len(s.bits), len(t.bits)), func(a, b uint64) uint64 { return a ^ b })
+}
+
+// Clone returns a copy of the set.
+func (s *RUTSet) Clone() *RUTSet {
+	return &RUTSet{bits: slices.Clone(s.bits), n: s.n}
+}
+
+// combine applies op word by word over the first n words of s and t,
+// treating missing words as zero.
+func combine(s, t *RUTSet, n int, op func(a, b uint64) uint64) *RUTSet {
+	out := &RUTSet{bits: make([]uint64, n)}
+	for i := range out.bits {
+		var a, b uint64
+		if i < len(s.bits) {
+			a = s.bits[i]
+		}
+		if i < len(t.bits) {
+			b = t.bits[i]
+		}
+		out.bits[i] = op(a, b)
+		out.n += bits.OnesCount64(out.bits[i])
+	}
+	return out
+}
@@ -0,0 +1,135 @@
+package rut
+
+import (
+	"bufio"
+	"compress/flate"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"math/bits"
+)
+
+// Serialized RUTSet layout, all integers little endian:
+//
+//	offset  size  field
+//	0       4     magic "RUTS"
+//	4       1     version (1)
+//	5       1     flags (bit 0: payload compressed with DEFLATE)
+//	6       2     reserved, zero
+//	8       8     number of RUTs
+//	16      8     number of 64-bit words in the bitset
+//	24      ...   bitset words, raw or compressed
+//	end-4   4     CRC-32 (IEEE) of the header and the uncompressed words
+//
+// Uncompressed files keep the bitset 8 byte aligned at offset 24, so they
+// can be memory mapped and read in place.
+const (
+	setMagic      = "RUTS"
+	setVersion    = 1
+	setHeaderSize = 24
+
+	setFlagCompressed = 1 << 0
+)
+
+// Save writes the set to w, compressing the bitset with DEFLATE if compress
+// is true. Compression shrinks sparse sets considerably at the cost of
+// memory mapping.
+func (s *RUTSet) Save(w io.Writer, compress bool) error {
+	var header [setHeaderSize]byte
+	copy(header[:], setMagic)
+	header[4] = setVersion
+	if compress {
+		header[5] = setFlagCompressed
+	}
+	binary.LittleEndian.PutUint64(header[8:], uint64(s.n))
+	binary.LittleEndian.PutUint64(header[16:], uint64(len(s.bits)))
+
+	bw := bufio.NewWriter(w)
+	bw.Write(header[:])
+	crc := crc32.NewIEEE()
+	crc.Write(header[:])
+
+	var payload io.Writer = bw
+	var fw *flate.Writer
+	if compress {
+		fw, _ = flate.NewWriter(bw, flate.DefaultCompression)
+		payload = fw
+	}
+
+	var word [8]byte
+	for _, v := range s.bits {
+		binary.LittleEndian.PutUint64(word[:], v)
+		crc.Write(word[:])
+		if _, err := payload.Write(word[:]); err != nil {
+			return err
+		}
+	}
+	if fw != nil {
+		if err := fw.Close(); err != nil {
+			return err
+		}
+	}
+
+	binary.LittleEndian.PutUint32(word[:4], crc.Sum32())
+	bw.Write(word[:4])
+	return bw.Flush()
+}
+
+// LoadRUTSet reads a set written by Save. It returns ErrInvalidEncoding if
+// the data is corrupt, fails the checksum or uses an unsupported version.
+func LoadRUTSet(r io.Reader) (*RUTSet, error) {
+	br := bufio.NewReader(r)
+
+	var header [setHeaderSize]byte
+	if _, err := io.ReadFull(br, header[:]); err != nil {
+		return nil, headerError(err)
+	}
+	if string(header[:4]) != setMagic || header[4] != setVersion || header[5]&^setFlagCompressed != 0 {
+		return nil, ErrInvalidEncoding
+	}
+	n := binary.LittleEndian.Uint64(header[8:])
+	words := binary.LittleEndian.Uint64(header[16:])
+	if words > uint64(wordIndex(MaxSetNumber)+1) || n > words*64 {
+		return nil, ErrInvalidEncoding
+	}
+
+	crc := crc32.NewIEEE()
+	crc.Write(header[:])
+
+	var payload io.Reader = br
+	if header[5]&setFlagCompressed != 0 {
+		fr := flate.NewReader(br)
+		defer fr.Close()
+		payload = fr
+	}
+
+	// The bitset grows as words are read, so a forged header cannot force
+	// a large allocation without the data to back it.
+	s := &RUTSet{bits: make([]uint64, 0, min(words, 1024))}
+	var word [8]byte
+	count := 0
+	for uint64(len(s.bits)) < words {
+		if _, err := io.ReadFull(payload, word[:]); err != nil {
+			return nil, headerError(err)
+		}
+		crc.Write(word[:])
+		v := binary.LittleEndian.Uint64(word[:])
+		s.bits = append(s.bits, v)
+		count += bits.OnesCount64(v)
+	}
+	if header[5]&setFlagCompressed != 0 {
+		// Let the decompressor consume its end of block marker
+		if _, err := payload.Read(word[:1]); err != io.EOF {
+			return nil, ErrInvalidEncoding
+		}
+	}
+
+	if _, err := io.ReadFull(br, word[:4]); err != nil {
+		return nil, headerError(err)
+	}
+	if binary.LittleEndian.Uint32(word[:4]) != crc.Sum32() || uint64(count) != n {
+		return nil, ErrInvalidEncoding
+	}
+	s.n = count
+	return s, nil
+}
@@ -0,0 +1,90 @@
+package rut
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"runtime"
+	"slices"
+	"testing"
+)
+
+func TestRUTSet_SaveLoad(t *testing.T) {
+	s := NewRUTSet(0)
+	for n := 1009; n < 30_000_000; n += 104_729 {
+		s.Add(RUT{Number: n})
+	}
+
+	for _, compress := range []bool{false, true} {
+		var buf bytes.Buffer
+		if err := s.Save(&buf, compress); err != nil {
+			t.Fatalf("Save(compress=%v) error = %v", compress, err)
+		}
+
+		got, err := LoadRUTSet(&buf)
+		if err != nil {
+			t.Fatalf("LoadRUTSet(compress=%v) error = %v", compress, err)
+		}
+		if got.Len() != s.Len() || !slices.Equal(numbersOf(got), numbersOf(s)) {
+			t.Errorf("LoadRUTSet(compress=%v) = %d RUTs; want %d matching RUTs", compress, got.Len(), s.Len())
+		}
+	}
+}
+
+func TestRUTSet_SaveCompressedIsSmaller(t *testing.T) {
+	s := setOf(1009, 12345678, 99999999)
+
+	var raw, compressed bytes.Buffer
+	s.Save(&raw, false)
+	s.Save(&compressed, true)
+	if compressed.Len() >= raw.Len()/100 {
+		t.Errorf("compressed size = %d; want well below raw size %d", compressed.Len(), raw.Len())
+	}
+}
+
+func TestLoadRUTSet_Invalid(t *testing.T) {
+	var buf bytes.Buffer
+	setOf(1009, 12345678).Save(&buf, false)
+	data := buf.Bytes()
+
+	corrupt := func(offset int) []byte {
+		b := slices.Clone(data)
+		b[offset] ^= 0xff
+		return b
+	}
+
+	tests := map[string][]byte{
+		"empty":     nil,
+		"magic":     corrupt(0),
+		"version":   corrupt(4),
+		"flags":     corrupt(5),
+		"count":     corrupt(8),
+		"payload":   corrupt(setHeaderSize + 8*(1009/64)),
+		"checksum":  corrupt(len(data) - 1),
+		"truncated": data[:len(data)-5],
+	}
+	for name, input := range tests {
+		if _, err := LoadRUTSet(bytes.NewReader(input)); !errors.Is(err, ErrInvalidEncoding) {
+			t.Errorf("%s: LoadRUTSet() error = %v; want %v", name, err, ErrInvalidEncoding)
+		}
+	}
+}
+
+func TestLoadRUTSet_ForgedHeader(t *testing.T) {
+	header := make([]byte, setHeaderSize)
+	copy(header, setMagic)
+	header[4] = setVersion
+	binary.LittleEndian.PutUint64(header[16:], uint64(wordIndex(MaxSetNumber)+1))
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	_, err := LoadRUTSet(bytes.NewReader(header))
+	runtime.ReadMemStats(&after)
+
+	if !errors.Is(err, ErrInvalidEncoding) {
+		t.Errorf("LoadRUTSet() error = %v; want %v", err, ErrInvalidEncoding)
+	}
+	if alloc := after.TotalAlloc - before.TotalAlloc; alloc > 1<<20 {
+		t.Errorf("LoadRUTSet() allocated %d bytes for a header without data", alloc)
+	}
+}
@@ -0,0 +1,139 @@
+package rut
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestRUTSet(t *testing.T) {
+	var s RUTSet
+
+	a := MustParse("12.345.678-5")
+	b := MustParse("1.009-K")
+	c := MustParse("99.999.999-9")
+
+	if !s.Add(a) || !s.Add(b) || !s.Add(c) {
+		t.Fatal("Add() = false for a new RUT; want true")
+	}
+	if s.Add(a) {
+		t.Error("Add() = true for an existing RUT; want false")
+	}
+	if s.Len() != 3 {
+		t.Errorf("Len() = %d; want 3", s.Len())
+	}
+
+	for _, r := range []RUT{a, b, c} {
+		if !s.Contains(r) {
+			t.Errorf("Contains(%v) = false; want true", r)
+		}
+	}
+	for _, r := range []RUT{MustParse("7.654.321-6"), {Number: 0}, {Number: -5}, {Number: 1 << 40}} {
+		if s.Contains(r) {
+			t.Errorf("Contains(%v) = true; want false", r)
+		}
+	}
+
+	if !s.Remove(b) || s.Remove(b) {
+		t.Error("Remove() did not report presence correctly")
+	}
+	if s.Contains(b) || s.Len() != 2 {
+		t.Errorf("after Remove(): Contains() = %v, Len() = %d; want false, 2", s.Contains(b), s.Len())
+	}
+}
+
+func TestRUTSet_OutOfRange(t *testing.T) {
+	s := NewRUTSet(1000)
+	for _, r := range []RUT{{Number: 0}, {Number: -1}, {Number: MaxSetNumber + 1}} {
+		if s.Add(r) {
+			t.Errorf("Add(%d) = true; want false", r.Number)
+		}
+	}
+	if s.Len() != 0 {
+		t.Errorf("Len() = %d; want 0", s.Len())
+	}
+}
+
+func TestRUTSet_Each(t *testing.T) {
+	s := NewRUTSet(20000000)
+	for _, n := range []int{12345678, 1009, 64, 63, 7654321} {
+		s.Add(RUT{Number: n})
+	}
+
+	var got []RUT
+	s.Each(func(r RUT) bool {
+		got = append(got, r)
+		return true
+	})
+	want := []RUT{
+		{Number: 63, DV: CalculateDV(63)},
+		{Number: 64, DV: CalculateDV(64)},
+		{Number: 1009, DV: 'K'},
+		{Number: 7654321, DV: '6'},
+		{Number: 12345678, DV: '5'},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("Each() = %v; want %v", got, want)
+	}
+
+	count := 0
+	s.Each(func(RUT) bool {
+		count++
+		return count < 2
+	})
+	if count != 2 {
+		t.Errorf("Each() called fn %d times after returning false; want 2", count)
+	}
+}
+
+func setOf(numbers ...int) *RUTSet {
+	s := &RUTSet{}
+	for _, n := range numbers {
+		s.Add(RUT{Number: n})
+	}
+	return s
+}
+
+func numbersOf(s *RUTSet) []int {
+	var out []int
+	s.Each(func(r RUT) bool {
+		out = append(out, r.Number)
+		return true
+	})
+	return out
+}
+
+func TestRUTSet_Algebra(t *testing.T) {
+	a := setOf(1009, 7654321, 12345678)
+	b := setOf(7654321, 12345678, 99999999)
+
+	tests := []struct {
+		name string
+		got  *RUTSet
+		want []int
+	}{
+		{"Union", a.Union(b), []int{1009, 7654321, 12345678, 99999999}},
+		{"Intersect", a.Intersect(b), []int{7654321, 12345678}},
+		{"Difference", a.Difference(b), []int{1009}},
+		{"Difference reversed", b.Difference(a), []int{99999999}},
+		{"SymmetricDifference", a.SymmetricDifference(b), []int{1009, 99999999}},
+		{"Union empty", a.Union(&RUTSet{}), []int{1009, 7654321, 12345678}},
+		{"Intersect empty", (&RUTSet{}).Intersect(a), nil},
+		{"Clone", a.Clone(), []int{1009, 7654321, 12345678}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := numbersOf(tt.got); !slices.Equal(got, tt.want) {
+				t.Errorf("%s = %v; want %v", tt.name, got, tt.want)
+			}
+			if tt.got.Len() != len(tt.want) {
+				t.Errorf("%s.Len() = %d; want %d", tt.name, tt.got.Len(), len(tt.want))
+			}
+		})
+	}
+
+	// Operands are left untouched
+	if got := numbersOf(a); !slices.Equal(got, []int{1009, 7654321, 12345678}) {
+		t.Errorf("operand modified: %v", got)
+	}
+}
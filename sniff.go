@@ -0,0 +1,139 @@
+package rut
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"io"
+	"strings"
+	"unicode"
+)
+
+// defaultSniffRows is the number of records SniffCSV samples by default.
+const defaultSniffRows = 1000
+
+// SniffOptions configures SniffCSV. The zero value is ready to use.
+type SniffOptions struct {
+	// Comma is the field delimiter. If 0, the most frequent of ',', ';',
+	// tab and '|' in the first line is used.
+	Comma rune
+	// Rows is the number of records sampled, 1000 if 0.
+	Rows int
+}
+
+// ColumnScore rates how much a CSV column looks like a column of RUTs.
+type ColumnScore struct {
+	Index  int          // Position of the column, from 0
+	Name   string       // Header of the column, "" without a header row
+	Report *AuditReport // Validity, formats and duplicates of the sampled values
+	Score  float64      // From 0 to 1, see SniffCSV
+}
+
+// SniffCSV samples the records of a CSV file and rates every column on
+// whether it holds RUTs, so bulk tools can pick the column instead of
+// asking the user. The score is the share of valid RUTs among the
+// non-empty sampled values, for 90%, and the share of those written in
+// their most common style, for 10%. Columns of plain numbers score about
+// 0.1, since one in eleven numbers ends in a matching check digit.
+//
+// The first record is taken as a header if none of its fields is a valid
+// RUT and one of them contains a letter other than 'K'. Records may have
+// different numbers of fields.
+func SniffCSV(r io.Reader, opts SniffOptions) ([]ColumnScore, error) {
+	if opts.Rows <= 0 {
+		opts.Rows = defaultSniffRows
+	}
+	br := bufio.NewReader(r)
+	cr := csv.NewReader(br)
+	cr.Comma = opts.Comma
+	if cr.Comma == 0 {
+		cr.Comma = sniffComma(br)
+	}
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = true
+	cr.ReuseRecord = true
+
+	var names []string
+	var columns [][]string
+	for rows, first := 0, true; rows < opts.Rows; first = false {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if first && isHeader(record) {
+			names = append(names, record...)
+			continue
+		}
+		rows++
+		for len(columns) < len(record) {
+			columns = append(columns, nil)
+		}
+		for j, v := range record {
+			columns[j] = append(columns[j], v)
+		}
+	}
+
+	scores := make([]ColumnScore, max(len(columns), len(names)))
+	for i := range scores {
+		s := &scores[i]
+		s.Index = i
+		if i < len(names) {
+			s.Name = names[i]
+		}
+		var values []string
+		if i < len(columns) {
+			values = columns[i]
+		}
+		s.Report = AuditValues(values)
+		if a := s.Report; a.Valid > 0 {
+			s.Score = 0.9*float64(a.Valid)/float64(a.Rows-a.Empty) +
+				0.1*float64(a.dominantStyle())/float64(a.Valid)
+		}
+	}
+	return scores, nil
+}
+
+// BestColumn returns the column with the highest score, if that score is at
+// least 0.5.
+func BestColumn(scores []ColumnScore) (ColumnScore, bool) {
+	var best ColumnScore
+	for _, s := range scores {
+		if s.Score > best.Score {
+			best = s
+		}
+	}
+	return best, best.Score >= 0.5
+}
+
+// sniffComma returns the candidate delimiter found most often in the first
+// line buffered by br.
+func sniffComma(br *bufio.Reader) rune {
+	head, _ := br.Peek(4096)
+	if i := bytes.IndexByte(head, '\n'); i >= 0 {
+		head = head[:i]
+	}
+	comma, most := ',', 0
+	for _, c := range []rune{',', ';', '\t', '|'} {
+		if n := bytes.Count(head, []byte(string(c))); n > most {
+			comma, most = c, n
+		}
+	}
+	return comma
+}
+
+// isHeader reports whether record looks like a header row.
+func isHeader(record []string) bool {
+	letters := false
+	for _, v := range record {
+		if Validate(v) {
+			return false
+		}
+		letters = letters || strings.IndexFunc(v, func(c rune) bool {
+			return unicode.IsLetter(c) && c != 'K' && c != 'k'
+		}) >= 0
+	}
+	return letters
+}
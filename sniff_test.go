@@ -0,0 +1,78 @@
+package rut
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestSniffCSV(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		opts  SniffOptions
+		names []string
+		want  []float64
+	}{
+		{
+			"header",
+			"id,nombre,rut\n1,Ana,12.345.678-5\n2,Luis,1.009-K\n3,Eva,12345678-0\n4,Juan,\n",
+			SniffOptions{},
+			[]string{"id", "nombre", "rut"},
+			[]float64{0, 0, 0.9*2/3 + 0.1},
+		},
+		{
+			"semicolons without header",
+			"12345678-5;100\n1009-K;200\n",
+			SniffOptions{},
+			[]string{"", ""},
+			[]float64{1, 0},
+		},
+		{
+			"mixed styles",
+			"12.345.678-5\n1009-K\n",
+			SniffOptions{Comma: ';'},
+			[]string{""},
+			[]float64{0.95},
+		},
+		{
+			"sample",
+			"rut\n12.345.678-5\nabc\n",
+			SniffOptions{Rows: 1},
+			[]string{"rut"},
+			[]float64{1},
+		},
+	}
+
+	for _, tt := range tests {
+		scores, err := SniffCSV(strings.NewReader(tt.input), tt.opts)
+		if err != nil {
+			t.Errorf("%s: SniffCSV() error = %v", tt.name, err)
+			continue
+		}
+		if len(scores) != len(tt.want) {
+			t.Errorf("%s: SniffCSV() = %d columns; want %d", tt.name, len(scores), len(tt.want))
+			continue
+		}
+		for i, s := range scores {
+			if s.Index != i || s.Name != tt.names[i] || math.Abs(s.Score-tt.want[i]) > 1e-9 {
+				t.Errorf("%s: column %d = %d %q %v; want %d %q %v", tt.name, i, s.Index, s.Name, s.Score, i, tt.names[i], tt.want[i])
+			}
+		}
+	}
+}
+
+func TestBestColumn(t *testing.T) {
+	scores, err := SniffCSV(strings.NewReader("nombre;rut\nAna;12.345.678-5\nLuis;1.009-K\n"), SniffOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	best, ok := BestColumn(scores)
+	if !ok || best.Index != 1 || best.Name != "rut" {
+		t.Errorf("BestColumn() = %+v, %v; want column 1", best, ok)
+	}
+
+	if _, ok := BestColumn([]ColumnScore{{Score: 0.2}}); ok {
+		t.Error("BestColumn() with low scores = true; want false")
+	}
+}
@@ -0,0 +1,201 @@
+package rut
+
+import (
+	"math/bits"
+	"slices"
+)
+
+// arrayMax is the cardinality above which a container switches from a
+// sorted array (2 bytes per entry) to a bitmap (8 KB).
+const arrayMax = 4096
+
+// SparseSet is a set of RUTs stored as a roaring bitmap: numbers are split
+// in chunks of 65536 and each chunk holds either a sorted array of the low
+// 16 bits or, once it grows past 4096 entries, a bitmap. Memory usage is
+// proportional to the number of RUTs rather than to the largest number, so
+// it suits allowlists of a few thousand RUTs where RUTSet would waste
+// megabytes.
+//
+// Like RUTSet, check digits are not stored. The zero value is an empty set
+// ready to use. A SparseSet is safe for concurrent reads but not for
+// concurrent modification.
+type SparseSet struct {
+	keys       []uint16 // High 16 bits of the numbers, sorted
+	containers []*container
+	n          int
+}
+
+// NewSparseSet returns an empty SparseSet.
+func NewSparseSet() *SparseSet {
+	return &SparseSet{}
+}
+
+// split returns the container key and the low 16 bits of a number.
+func split(number int) (uint16, uint16) {
+	return uint16(number >> 16), uint16(number)
+}
+
+// Add adds r to the set and reports whether it was not already present.
+// Numbers below 1 or above MaxSetNumber are not added.
+func (s *SparseSet) Add(r RUT) bool {
+	if r.Number < 1 || r.Number > MaxSetNumber {
+		return false
+	}
+	key, low := split(r.Number)
+	i, ok := slices.BinarySearch(s.keys, key)
+	if !ok {
+		s.keys = slices.Insert(s.keys, i, key)
+		s.containers = slices.Insert(s.containers, i, &container{})
+	}
+	if !s.containers[i].add(low) {
+		return false
+	}
+	s.n++
+	return true
+}
+
+// Remove removes r from the set and reports whether it was present.
+func (s *SparseSet) Remove(r RUT) bool {
+	if r.Number < 1 || r.Number > MaxSetNumber {
+		return false
+	}
+	key, low := split(r.Number)
+	i, ok := slices.BinarySearch(s.keys, key)
+	if !ok || !s.containers[i].remove(low) {
+		return false
+	}
+	if s.containers[i].n == 0 {
+		s.keys = slices.Delete(s.keys, i, i+1)
+		s.containers = slices.Delete(s.containers, i, i+1)
+	}
+	s.n--
+	return true
+}
+
+// Contains reports whether the number of r is in the set.
+func (s *SparseSet) Contains(r RUT) bool {
+	if r.Number < 1 || r.Number > MaxSetNumber {
+		return false
+	}
+	key, low := split(r.Number)
+	i, ok := slices.BinarySearch(s.keys, key)
+	return ok && s.containers[i].contains(low)
+}
+
+// Len returns the number of RUTs in the set.
+func (s *SparseSet) Len() int {
+	return s.n
+}
+
+// Each calls fn for every RUT in the set in increasing order, until fn
+// returns false.
+func (s *SparseSet) Each(fn func(RUT) bool) {
+	for i, c := range s.containers {
+		high := int(s.keys[i]) << 16
+		if !c.each(func(low uint16) bool {
+			number := high | int(low)
+			return fn(RUT{Number: number, DV: CalculateDV(number)})
+		}) {
+			return
+		}
+	}
+}
+
+// container holds the low 16 bits of the numbers sharing a key.
+type container struct {
+	array  []uint16 // Sorted values, used while bitmap is nil
+	bitmap []uint64 // 1024 words, used once n exceeds arrayMax
+	n      int
+}
+
+func (c *container) add(v uint16) bool {
+	if c.bitmap != nil {
+		w, mask := v>>6, uint64(1)<<(v&63)
+		if c.bitmap[w]&mask != 0 {
+			return false
+		}
+		c.bitmap[w] |= mask
+		c.n++
+		return true
+	}
+
+	i, ok := slices.BinarySearch(c.array, v)
+	if ok {
+		return false
+	}
+	c.array = slices.Insert(c.array, i, v)
+	c.n++
+	if c.n > arrayMax {
+		c.toBitmap()
+	}
+	return true
+}
+
+func (c *container) remove(v uint16) bool {
+	if c.bitmap != nil {
+		w, mask := v>>6, uint64(1)<<(v&63)
+		if c.bitmap[w]&mask == 0 {
+			return false
+		}
+		c.bitmap[w] &^= mask
+		c.n--
+		if c.n <= arrayMax/2 {
+			c.toArray()
+		}
+		return true
+	}
+
+	i, ok := slices.BinarySearch(c.array, v)
+	if !ok {
+		return false
+	}
+	c.array = slices.Delete(c.array, i, i+1)
+	c.n--
+	return true
+}
+
+func (c *container) contains(v uint16) bool {
+	if c.bitmap != nil {
+		return c.bitmap[v>>6]&(uint64(1)<<(v&63)) != 0
+	}
+	_, ok := slices.BinarySearch(c.array, v)
+	return ok
+}
+
+func (c *container) each(fn func(uint16) bool) bool {
+	if c.bitmap == nil {
+		for _, v := range c.array {
+			if !fn(v) {
+				return false
+			}
+		}
+		return true
+	}
+	for w, word := range c.bitmap {
+		for word != 0 {
+			if !fn(uint16(w<<6 | bits.TrailingZeros64(word))) {
+				return false
+			}
+			word &= word - 1
+		}
+	}
+	return true
+}
+
+func (c *container) toBitmap() {
+	c.bitmap = make([]uint64, 1024)
+	for _, v := range c.array {
+		c.bitmap[v>>6] |= uint64(1) << (v & 63)
+	}
+	c.array = nil
+}
+
+func (c *container) toArray() {
+	array := make([]uint16, 0, c.n)
+	c.each(func(v uint16) bool {
+		array = append(array, v)
+		return true
+	})
+	c.array = array
+	c.bitmap = nil
+}
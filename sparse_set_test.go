@@ -0,0 +1,78 @@
+package rut
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSparseSet(t *testing.T) {
+	s := NewSparseSet()
+
+	a := MustParse("12.345.678-5")
+	b := MustParse("1.009-K")
+	c := MustParse("99.999.999-9")
+
+	if !s.Add(a) || !s.Add(b) || !s.Add(c) {
+		t.Fatal("Add() = false for a new RUT; want true")
+	}
+	if s.Add(a) {
+		t.Error("Add() = true for an existing RUT; want false")
+	}
+	if s.Len() != 3 {
+		t.Errorf("Len() = %d; want 3", s.Len())
+	}
+	for _, r := range []RUT{a, b, c} {
+		if !s.Contains(r) {
+			t.Errorf("Contains(%v) = false; want true", r)
+		}
+	}
+	for _, r := range []RUT{MustParse("7.654.321-6"), {Number: 0}, {Number: -5}, {Number: 1 << 40}} {
+		if s.Contains(r) {
+			t.Errorf("Contains(%v) = true; want false", r)
+		}
+	}
+
+	if !s.Remove(b) || s.Remove(b) {
+		t.Error("Remove() did not report presence correctly")
+	}
+	if s.Contains(b) || s.Len() != 2 {
+		t.Errorf("after Remove(): Contains() = %v, Len() = %d; want false, 2", s.Contains(b), s.Len())
+	}
+}
+
+// TestSparseSet_MatchesRUTSet fills both implementations through the Set
+// interface, crossing the array to bitmap threshold, and compares them.
+func TestSparseSet_MatchesRUTSet(t *testing.T) {
+	sets := []Set{NewRUTSet(0), NewSparseSet()}
+
+	for _, s := range sets {
+		// Dense chunk that switches to a bitmap container
+		for n := 1_000_000; n < 1_000_000+3*arrayMax; n += 2 {
+			s.Add(RUT{Number: n})
+		}
+		// Sparse numbers across many chunks
+		for n := 1; n < 90_000_000; n += 999_983 {
+			s.Add(RUT{Number: n})
+		}
+		// Shrink the dense chunk back to an array container
+		for n := 1_000_000; n < 1_000_000+3*arrayMax; n += 4 {
+			s.Remove(RUT{Number: n})
+		}
+	}
+
+	var want, got []RUT
+	sets[0].Each(func(r RUT) bool { want = append(want, r); return true })
+	sets[1].Each(func(r RUT) bool { got = append(got, r); return true })
+
+	if sets[0].Len() != sets[1].Len() {
+		t.Errorf("SparseSet.Len() = %d; want %d", sets[1].Len(), sets[0].Len())
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("SparseSet.Each() yielded %d RUTs that differ from RUTSet", len(got))
+	}
+	for _, r := range want {
+		if !sets[1].Contains(r) {
+			t.Fatalf("SparseSet.Contains(%v) = false; want true", r)
+		}
+	}
+}
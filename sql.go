@@ -0,0 +1,40 @@
+package rut
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// Value implements driver.Valuer, storing the RUT as a FormatComplete
+// string, or NULL for the zero RUT.
+func (r RUT) Value() (driver.Value, error) {
+	if r.IsZero() {
+		return nil, nil
+	}
+	return r.Format(FormatComplete), nil
+}
+
+// Scan implements sql.Scanner. It accepts strings in any format supported
+// by Parse, returning ErrInvalidDV for a wrong check digit, and integer
+// columns holding the number alone, whose check digit is computed. NULL
+// scans into the zero RUT.
+func (r *RUT) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*r = RUT{}
+		return nil
+	case string:
+		return r.UnmarshalText([]byte(v))
+	case []byte:
+		return r.UnmarshalText(v)
+	case int64:
+		return r.unmarshalNumber(v)
+	}
+	return fmt.Errorf("rut: cannot scan %T into RUT", src)
+}
+
+// GormDataType implements the GormDataTypeInterface of GORM, declaring RUT
+// columns as strings. See the rutgorm package for other column formats.
+func (RUT) GormDataType() string {
+	return "string"
+}
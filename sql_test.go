@@ -0,0 +1,48 @@
+package rut
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRUT_Value(t *testing.T) {
+	v, err := MustParse("1009-k").Value()
+	if err != nil || v != "1.009-K" {
+		t.Errorf("Value() = %v, %v; want %q, nil", v, err, "1.009-K")
+	}
+	if v, err := (RUT{}).Value(); err != nil || v != nil {
+		t.Errorf("Value(zero) = %v, %v; want nil, nil", v, err)
+	}
+}
+
+func TestRUT_Scan(t *testing.T) {
+	tests := []struct {
+		src     any
+		want    RUT
+		wantErr error
+	}{
+		{"12.345.678-5", MustParse("12.345.678-5"), nil},
+		{[]byte("60803000k"), MustParse("60.803.000-K"), nil},
+		{int64(1009), MustParse("1.009-K"), nil},
+		{nil, RUT{}, nil},
+		{"12.345.678-0", RUT{}, ErrInvalidDV},
+		{int64(-1), RUT{}, ErrInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		got := MustParse("7.654.321-6")
+		err := got.Scan(tt.src)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("Scan(%v) error = %v; want %v", tt.src, err, tt.wantErr)
+			continue
+		}
+		if err == nil && got != tt.want {
+			t.Errorf("Scan(%v) = %v; want %v", tt.src, got, tt.want)
+		}
+	}
+
+	var r RUT
+	if err := r.Scan(1.5); err == nil {
+		t.Errorf("Scan(1.5) error = nil; want an error")
+	}
+}
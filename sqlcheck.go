@@ -0,0 +1,120 @@
+package rut
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Dialect is an SQL dialect for CheckConstraint.
+type Dialect int
+
+const (
+	Postgres Dialect = iota
+	MySQL            // MySQL 8.0.16 or later, which enforces CHECK
+	SQLite
+)
+
+// checkWeights are the mod 11 multipliers for a number left padded to 9
+// digits, from its leftmost digit.
+var checkWeights = [9]int{4, 3, 2, 7, 6, 5, 4, 3, 2}
+
+// CheckConstraint returns a boolean SQL expression that holds when column
+// is a valid RUT written in style, for use in a CHECK constraint:
+//
+//	expr, err := rut.CheckConstraint(rut.Postgres, "emisor", rut.FormatWithDash)
+//	// ALTER TABLE invoices ADD CONSTRAINT emisor_rut CHECK (<expr>)
+//
+// The expression checks the exact format, with an uppercase K, as well as
+// the check digit, and accepts the same 4 to 9 digit numbers as Parse
+// without leading zeros. NULL passes, as with any CHECK constraint. column
+// is inserted as is and must be quoted by the caller if needed.
+//
+// The supported styles are FormatComplete, FormatEscaped, FormatWithDash
+// and FormatSpaces.
+func CheckConstraint(d Dialect, column string, style FormatStyle) (string, error) {
+	var sep, dash string
+	switch style {
+	case FormatComplete:
+		sep, dash = ".", "-"
+	case FormatSpaces:
+		sep, dash = " ", "-"
+	case FormatWithDash:
+		dash = "-"
+	case FormatEscaped:
+	default:
+		return "", fmt.Errorf("rut: no CHECK constraint for style %d", style)
+	}
+
+	// number holds the digits before the check digit, without separators
+	length, left, right, intType := "length", "left", "right", "integer"
+	switch d {
+	case Postgres:
+	case MySQL:
+		length, left, right, intType = "CHAR_LENGTH", "LEFT", "RIGHT", "UNSIGNED"
+	case SQLite:
+	default:
+		return "", fmt.Errorf("rut: unknown SQL dialect %d", d)
+	}
+	var number, dv, padded string
+	if d == SQLite {
+		number = fmt.Sprintf("substr(%s, 1, length(%s) - %d)", column, column, 1+len(dash))
+		dv = fmt.Sprintf("substr(%s, -1)", column)
+	} else {
+		number = fmt.Sprintf("%s(%s, %s(%s) - %d)", left, column, length, column, 1+len(dash))
+		dv = fmt.Sprintf("%s(%s, 1)", right, column)
+	}
+	if sep != "" {
+		number = fmt.Sprintf("replace(%s, '%s', '')", number, sep)
+	}
+	if d == SQLite {
+		padded = fmt.Sprintf("substr('000000000' || %s, -9)", number)
+	} else {
+		padded = fmt.Sprintf("lpad(%s, 9, '0')", number)
+	}
+
+	var sum strings.Builder
+	for i, w := range checkWeights {
+		if i > 0 {
+			sum.WriteString(" + ")
+		}
+		fmt.Fprintf(&sum, "CAST(substr(%s, %d, 1) AS %s) * %d", padded, i+1, intType, w)
+	}
+
+	// The check digit for a sum s is the character at s % 11 in "0K987654321"
+	valid := fmt.Sprintf("%s = substr('0K987654321', (%s) %% 11 + 1, 1)", dv, sum.String())
+
+	// CASE guarantees the casts only run on well formed values
+	return fmt.Sprintf("%s IS NULL OR CASE WHEN %s THEN %s ELSE FALSE END",
+		column, checkFormat(d, column, sep, dash), valid), nil
+}
+
+// checkFormat returns an SQL expression matching the layout of a RUT with a
+// 4 to 9 digit number.
+func checkFormat(d Dialect, column, sep, dash string) string {
+	if d == SQLite {
+		// SQLite has no regular expressions, use a GLOB per number length
+		var patterns []string
+		for n := 4; n <= 9; n++ {
+			var p strings.Builder
+			p.WriteString("[1-9]")
+			for i := 1; i < n; i++ {
+				if sep != "" && (n-i)%3 == 0 {
+					p.WriteString(sep)
+				}
+				p.WriteString("[0-9]")
+			}
+			patterns = append(patterns, fmt.Sprintf("%s GLOB '%s%s[0-9K]'", column, p.String(), dash))
+		}
+		return "(" + strings.Join(patterns, " OR ") + ")"
+	}
+
+	re := "^[1-9][0-9]{3,8}" + dash + "[0-9K]$"
+	if sep != "" {
+		re = "^[1-9][0-9]{0,2}[" + sep + "][0-9]{3}([" + sep + "][0-9]{3})?" + dash + "[0-9K]$"
+	}
+	if d == MySQL {
+		// The c flag makes the match case sensitive, rejecting a lowercase k
+		return fmt.Sprintf("REGEXP_LIKE(%s, '%s', 'c')", column, re)
+	}
+	return fmt.Sprintf("%s ~ '%s'", column, re)
+}
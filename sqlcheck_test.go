@@ -0,0 +1,56 @@
+package rut
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestCheckConstraint(t *testing.T) {
+	tests := []struct {
+		dialect Dialect
+		style   FormatStyle
+		want    []string
+	}{
+		{Postgres, FormatWithDash, []string{"emisor IS NULL OR ", "emisor ~ '^[1-9][0-9]{3,8}-[0-9K]$'", "lpad(left(emisor, length(emisor) - 2), 9, '0')", "AS integer"}},
+		{Postgres, FormatComplete, []string{"emisor ~ '^[1-9][0-9]{0,2}[.][0-9]{3}([.][0-9]{3})?-[0-9K]$'", "replace(left(emisor, length(emisor) - 2), '.', '')"}},
+		{MySQL, FormatEscaped, []string{"REGEXP_LIKE(emisor, '^[1-9][0-9]{3,8}[0-9K]$', 'c')", "CHAR_LENGTH(emisor) - 1", "AS UNSIGNED"}},
+		{SQLite, FormatWithDash, []string{"emisor GLOB '[1-9][0-9][0-9][0-9]-[0-9K]'", "substr('000000000' || substr(emisor, 1, length(emisor) - 2), -9)"}},
+		{SQLite, FormatSpaces, []string{"emisor GLOB '[1-9] [0-9][0-9][0-9] [0-9][0-9][0-9]-[0-9K]'"}},
+	}
+
+	for _, tt := range tests {
+		got, err := CheckConstraint(tt.dialect, "emisor", tt.style)
+		if err != nil {
+			t.Errorf("CheckConstraint(%d, %d) error = %v", tt.dialect, tt.style, err)
+			continue
+		}
+		for _, want := range tt.want {
+			if !strings.Contains(got, want) {
+				t.Errorf("CheckConstraint(%d, %d) = %s; want it to contain %s", tt.dialect, tt.style, got, want)
+			}
+		}
+	}
+
+	if _, err := CheckConstraint(Postgres, "emisor", FormatNumberOnly); err == nil {
+		t.Errorf("CheckConstraint(FormatNumberOnly) error = nil; want an error")
+	}
+	if _, err := CheckConstraint(Dialect(42), "emisor", FormatComplete); err == nil {
+		t.Errorf("CheckConstraint(Dialect(42)) error = nil; want an error")
+	}
+}
+
+// TestCheckConstraint_Digit mirrors the check digit computation of the SQL
+// expression.
+func TestCheckConstraint_Digit(t *testing.T) {
+	for n := 1000; n < 1_000_000_000; n = n*7 + 13 {
+		padded := fmt.Sprintf("%09d", n)
+		sum := 0
+		for i, w := range checkWeights {
+			sum += int(padded[i]-'0') * w
+		}
+		if got, want := "0K987654321"[sum%11], CalculateDV(n); got != want {
+			t.Errorf("SQL check digit of %d = %c; want %c", n, got, want)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package rut
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrUnknownStyle is returned by DetectStyle when the separators of a
+// parseable RUT do not follow any of the format styles.
+var ErrUnknownStyle = errors.New("rut: separators do not match a known style")
+
+// DetectStyle reports the format style a RUT string is written in,
+// ignoring surrounding spaces. It returns the Parse error for malformed
+// input and ErrUnknownStyle when the separators are misplaced or mixed, as
+// in "1234.5678-5".
+//
+// RUTs below 1.000 have no dots, so they are reported as FormatWithDash
+// rather than FormatComplete.
+func DetectStyle(s string) (FormatStyle, error) {
+	if _, err := Parse(s); err != nil {
+		return 0, err
+	}
+	style, ok := detectStyle(strings.TrimSpace(s))
+	if !ok {
+		return 0, ErrUnknownStyle
+	}
+	return style, nil
+}
+
+// Reformat normalizes a RUT string and formats it in the target style.
+// With FormatPreserve the detected style of s is kept, so only the other
+// aspects are normalized: surrounding spaces, leading zeros and the case
+// of the check digit.
+func Reformat(s string, target FormatStyle) (string, error) {
+	r, err := Parse(s)
+	if err != nil {
+		return "", err
+	}
+	if target == FormatPreserve {
+		if target, err = DetectStyle(s); err != nil {
+			return "", err
+		}
+	}
+	return r.Format(target), nil
+}
+
+// detectStyle reports the style of a string whose separators follow one of
+// the supported styles. The characters themselves are not validated.
+func detectStyle(s string) (FormatStyle, bool) {
+	var (
+		dash   = -1
+		sep    byte
+		groups int
+	)
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '-':
+			if dash >= 0 {
+				return 0, false
+			}
+			dash = i
+		case '.', ' ':
+			// Dots and spaces cannot be mixed
+			if sep != 0 && sep != c {
+				return 0, false
+			}
+			sep = c
+			groups++
+		}
+	}
+
+	if dash < 0 {
+		if groups > 0 {
+			return 0, false
+		}
+		return FormatEscaped, true
+	}
+	// The dash must precede a single check digit
+	if dash != len(s)-2 || dash == 0 {
+		return 0, false
+	}
+	if groups == 0 {
+		return FormatWithDash, true
+	}
+
+	// Separators must group the number by thousands from the right
+	group := 0
+	for i := dash - 1; i >= 0; i-- {
+		if s[i] == sep {
+			if group != 3 || i == 0 {
+				return 0, false
+			}
+			group = 0
+			continue
+		}
+		group++
+	}
+	if group > 3 {
+		return 0, false
+	}
+	if sep == ' ' {
+		return FormatSpaces, true
+	}
+	return FormatComplete, true
+}
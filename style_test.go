@@ -0,0 +1,70 @@
+package rut
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDetectStyle(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    FormatStyle
+		wantErr error
+	}{
+		{"12.345.678-5", FormatComplete, nil},
+		{"12345678-5", FormatWithDash, nil},
+		{"123456785", FormatEscaped, nil},
+		{"12 345 678-5", FormatSpaces, nil},
+		{" 12345678-5 ", FormatWithDash, nil},
+		{"1.009-k", FormatComplete, nil},
+		{"123.456.789-2", FormatComplete, nil},
+		{"1234.5678-5", 0, ErrUnknownStyle},
+		{"12.345678-5", 0, ErrUnknownStyle},
+		{"12.345 678-5", 0, ErrUnknownStyle},
+		{"12.345.6785", 0, ErrUnknownStyle},
+		{"1234567-85", 0, ErrUnknownStyle},
+		{"12--345678-5", 0, ErrUnknownStyle},
+		{"", 0, ErrEmptyRUT},
+		{"abc-d", 0, ErrInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		got, err := DetectStyle(tt.input)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("DetectStyle(%q) error = %v; want %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if err == nil && got != tt.want {
+			t.Errorf("DetectStyle(%q) = %v; want %v", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestReformat(t *testing.T) {
+	tests := []struct {
+		input    string
+		target   FormatStyle
+		expected string
+		wantErr  error
+	}{
+		{"12.345.678-5", FormatWithDash, "12345678-5", nil},
+		{"1234.5678-5", FormatComplete, "12.345.678-5", nil},
+		{"1.009-k", FormatPreserve, "1.009-K", nil},
+		{"01009-k", FormatPreserve, "1009-K", nil},
+		{" 1009k ", FormatPreserve, "1009K", nil},
+		{"012 345 678-5", FormatPreserve, "12 345 678-5", nil},
+		{"1234.5678-5", FormatPreserve, "", ErrUnknownStyle},
+		{"abc", FormatPreserve, "", ErrInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		got, err := Reformat(tt.input, tt.target)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("Reformat(%q, %v) error = %v; want %v", tt.input, tt.target, err, tt.wantErr)
+			continue
+		}
+		if got != tt.expected {
+			t.Errorf("Reformat(%q, %v) = %q; want %q", tt.input, tt.target, got, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package rut
+
+import "strconv"
+
+// Suggest returns likely intended RUTs for a string that is well formed
+// but fails check digit validation, most plausible first.
+//
+// Candidates are ranked as follows:
+//   - Transpositions of two adjacent characters (including the check digit)
+//   - The same number with its correct check digit
+//   - Substitutions of a single digit of the number that match the check digit
+//
+// It returns nil if the string is valid or cannot be parsed.
+func Suggest(s string) []RUT {
+	r, err := Parse(s)
+	if err != nil || r.Validate() {
+		return nil
+	}
+
+	digits := []byte(strconv.Itoa(r.Number))
+	digits = append(digits, r.DV)
+
+	var out []RUT
+	seen := make(map[RUT]bool)
+	add := func(c RUT) {
+		if c != r && !seen[c] && c.Validate() {
+			seen[c] = true
+			out = append(out, c)
+		}
+	}
+
+	// Adjacent transpositions
+	for i := 0; i < len(digits)-1; i++ {
+		if digits[i] == digits[i+1] {
+			continue
+		}
+		digits[i], digits[i+1] = digits[i+1], digits[i]
+		if c, ok := fromDigits(digits); ok {
+			add(c)
+		}
+		digits[i], digits[i+1] = digits[i+1], digits[i]
+	}
+
+	// Wrong check digit
+	add(RUT{Number: r.Number, DV: CalculateDV(r.Number)})
+
+	// Single digit substitutions in the number
+	num := digits[:len(digits)-1]
+	for i := range num {
+		orig := num[i]
+		for d := byte('0'); d <= '9'; d++ {
+			if d == orig || (i == 0 && d == '0') {
+				continue
+			}
+			num[i] = d
+			if c, ok := fromDigits(digits); ok {
+				add(c)
+			}
+		}
+		num[i] = orig
+	}
+
+	return out
+}
+
+// fromDigits builds a RUT from a buffer holding the number digits followed
+// by the check digit. It reports false if the buffer is not well formed.
+func fromDigits(b []byte) (RUT, bool) {
+	if len(b) < 2 || b[0] == '0' {
+		return RUT{}, false
+	}
+	num := 0
+	for _, c := range b[:len(b)-1] {
+		if c < '0' || c > '9' {
+			return RUT{}, false
+		}
+		num = num*10 + int(c-'0')
+	}
+	return RUT{Number: num, DV: b[len(b)-1]}, true
+}
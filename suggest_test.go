@@ -0,0 +1,51 @@
+package rut
+
+import (
+	"testing"
+)
+
+func TestSuggest(t *testing.T) {
+	tests := []struct {
+		input string
+		first string
+	}{
+		{"12.345.687-5", "12.345.678-5"}, // Transposed digits
+		{"12.345.678-0", "12.345.678-5"}, // Wrong DV
+		{"7.654.321-0", "7.654.321-6"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got := Suggest(tt.input)
+			if len(got) == 0 {
+				t.Fatalf("Suggest(%q) returned no suggestions", tt.input)
+			}
+			if got[0].String() != tt.first {
+				t.Errorf("Suggest(%q)[0] = %q; want %q", tt.input, got[0].String(), tt.first)
+			}
+			for _, r := range got {
+				if !r.Validate() {
+					t.Errorf("Suggest(%q) returned invalid RUT %q", tt.input, r.String())
+				}
+			}
+		})
+	}
+}
+
+func TestSuggest_SingleDigit(t *testing.T) {
+	got := Suggest("11.111.112-1")
+	for _, r := range got {
+		if r.Number == 11111111 {
+			return
+		}
+	}
+	t.Errorf("Suggest(%q) = %v; want it to contain 11.111.111-1", "11.111.112-1", got)
+}
+
+func TestSuggest_NoSuggestions(t *testing.T) {
+	for _, input := range []string{"12.345.678-5", "", "abc-d", "123"} {
+		if got := Suggest(input); got != nil {
+			t.Errorf("Suggest(%q) = %v; want nil", input, got)
+		}
+	}
+}
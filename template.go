@@ -0,0 +1,101 @@
+package rut
+
+import "fmt"
+
+// styleNames maps the style names accepted by the rutFormat template
+// function to styles.
+var styleNames = map[string]FormatStyle{
+	"complete": FormatComplete,
+	"dash":     FormatWithDash,
+	"escaped":  FormatEscaped,
+	"spaces":   FormatSpaces,
+	"number":   FormatNumberOnly,
+	"dots":     FormatNumberDots,
+}
+
+// TemplateFuncs returns functions for html/template and text/template, so
+// pages and emails can print RUTs without helpers of their own:
+//
+//	t := template.New("page").Funcs(rut.TemplateFuncs())
+//
+// The functions take a RUT, a *RUT, a string or an integer number:
+//
+//   - rutFormat formats the value in FormatComplete style, or in the style
+//     named by an optional second argument: "complete", "dash", "escaped",
+//     "spaces", "number" or "dots". Strings that do not parse are printed
+//     unchanged.
+//   - rutMask prints the value masked as by RUT.Mask, or nothing if it does
+//     not parse.
+//   - rutValid reports whether the value is a RUT with a valid check digit.
+//
+// The result can be passed to Funcs of both template packages.
+func TemplateFuncs() map[string]any {
+	return map[string]any{
+		"rutFormat": templateFormat,
+		"rutMask":   templateMask,
+		"rutValid":  templateValid,
+	}
+}
+
+func templateFormat(v any, style ...string) (string, error) {
+	s := FormatComplete
+	switch len(style) {
+	case 0:
+	case 1:
+		var ok bool
+		if s, ok = styleNames[style[0]]; !ok {
+			return "", fmt.Errorf("rut: rutFormat: unknown style %q", style[0])
+		}
+	default:
+		return "", fmt.Errorf("rut: rutFormat: too many arguments")
+	}
+	r, ok := templateValue(v)
+	if !ok {
+		if str, isString := v.(string); isString {
+			return str, nil
+		}
+		return "", nil
+	}
+	if r.IsZero() {
+		return "", nil
+	}
+	return r.Format(s), nil
+}
+
+func templateMask(v any) string {
+	r, _ := templateValue(v)
+	return r.Mask()
+}
+
+func templateValid(v any) bool {
+	r, ok := templateValue(v)
+	return ok && r.Validate()
+}
+
+// templateValue converts a template function argument to a RUT. Numbers
+// get their check digit computed.
+func templateValue(v any) (RUT, bool) {
+	switch v := v.(type) {
+	case RUT:
+		return v, true
+	case *RUT:
+		return FromPtr(v), v != nil
+	case string:
+		r, err := Parse(v)
+		return r, err == nil
+	case int:
+		return templateNumber(int64(v))
+	case int32:
+		return templateNumber(int64(v))
+	case int64:
+		return templateNumber(v)
+	case uint32:
+		return templateNumber(int64(v))
+	}
+	return RUT{}, false
+}
+
+func templateNumber(n int64) (RUT, bool) {
+	r, err := New(n)
+	return r, err == nil
+}
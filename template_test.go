@@ -0,0 +1,61 @@
+package rut
+
+import (
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+	"text/template"
+)
+
+func TestTemplateFuncs(t *testing.T) {
+	r := MustParse("12.345.678-5")
+	tests := []struct {
+		text     string
+		data     any
+		expected string
+	}{
+		{`{{rutFormat .}}`, r, "12.345.678-5"},
+		{`{{rutFormat . "dash"}}`, r, "12345678-5"},
+		{`{{rutFormat . "spaces"}}`, &r, "12 345 678-5"},
+		{`{{rutFormat .}}`, "123456785", "12.345.678-5"},
+		{`{{rutFormat .}}`, "not a rut", "not a rut"},
+		{`{{rutFormat .}}`, 1009, "1.009-K"},
+		{`{{rutFormat .}}`, RUT{}, ""},
+		{`{{rutFormat .}}`, (*RUT)(nil), ""},
+		{`{{rutMask .}}`, r, "**.***.678-5"},
+		{`{{rutMask .}}`, "not a rut", ""},
+		{`{{rutValid .}}`, r, "true"},
+		{`{{rutValid .}}`, "12.345.678-0", "false"},
+		{`{{if rutValid .}}ok{{end}}`, int64(12345678), "ok"},
+	}
+
+	for _, tt := range tests {
+		tmpl := template.Must(template.New("").Funcs(TemplateFuncs()).Parse(tt.text))
+		var b strings.Builder
+		if err := tmpl.Execute(&b, tt.data); err != nil {
+			t.Errorf("Execute(%s, %v) error = %v", tt.text, tt.data, err)
+			continue
+		}
+		if got := b.String(); got != tt.expected {
+			t.Errorf("Execute(%s, %v) = %q; want %q", tt.text, tt.data, got, tt.expected)
+		}
+	}
+}
+
+func TestTemplateFuncs_HTML(t *testing.T) {
+	tmpl := htmltemplate.Must(htmltemplate.New("").Funcs(TemplateFuncs()).Parse(`<td>{{rutFormat .}}</td>`))
+	var b strings.Builder
+	if err := tmpl.Execute(&b, MustParse("1.009-K")); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if got := b.String(); got != "<td>1.009-K</td>" {
+		t.Errorf("Execute() = %q; want %q", got, "<td>1.009-K</td>")
+	}
+}
+
+func TestTemplateFuncs_UnknownStyle(t *testing.T) {
+	tmpl := template.Must(template.New("").Funcs(TemplateFuncs()).Parse(`{{rutFormat . "bogus"}}`))
+	if err := tmpl.Execute(new(strings.Builder), MustParse("1.009-K")); err == nil {
+		t.Error("Execute() error = nil; want an error for an unknown style")
+	}
+}
@@ -0,0 +1,68 @@
+package rut
+
+import (
+	"strconv"
+	"strings"
+)
+
+// DVStep is one digit of the weighted sum behind a check digit.
+type DVStep struct {
+	Digit      int // Digit of the number, starting from the rightmost
+	Multiplier int // Weight of the digit, cycling from 2 to 7
+	Product    int // Digit * Multiplier
+	Sum        int // Running sum up to and including this step
+}
+
+// DVTrace explains how CalculateDV arrives at a check digit, for support
+// tools and training material.
+type DVTrace struct {
+	Number    int
+	Steps     []DVStep // One per digit, from right to left
+	Sum       int      // Sum of the products
+	Remainder int      // Sum % 11
+	Result    int      // 11 - Remainder; 11 stands for '0' and 10 for 'K'
+	DV        byte
+}
+
+// CalculateDVTrace computes the check digit of number like CalculateDV,
+// recording every step of the modulo 11 algorithm.
+func CalculateDVTrace(number int) DVTrace {
+	t := DVTrace{Number: number}
+	for i, n := 0, number; n > 0; i, n = i+1, n/10 {
+		step := DVStep{Digit: n % 10, Multiplier: multipliers[i%6]}
+		step.Product = step.Digit * step.Multiplier
+		t.Sum += step.Product
+		step.Sum = t.Sum
+		t.Steps = append(t.Steps, step)
+	}
+	t.Remainder = t.Sum % 11
+	t.Result = 11 - t.Remainder
+	t.DV = dvFromSum(t.Sum)
+	return t
+}
+
+// String returns the trace as text, one line per step:
+//
+//	8 x 2 = 16, sum 16
+//	7 x 3 = 21, sum 37
+//	...
+//	138 mod 11 = 6
+//	11 - 6 = 5
+//	check digit 5
+func (t DVTrace) String() string {
+	var b strings.Builder
+	for _, s := range t.Steps {
+		b.WriteString(strconv.Itoa(s.Digit) + " x " + strconv.Itoa(s.Multiplier) +
+			" = " + strconv.Itoa(s.Product) + ", sum " + strconv.Itoa(s.Sum) + "\n")
+	}
+	b.WriteString(strconv.Itoa(t.Sum) + " mod 11 = " + strconv.Itoa(t.Remainder) + "\n")
+	b.WriteString("11 - " + strconv.Itoa(t.Remainder) + " = " + strconv.Itoa(t.Result))
+	switch t.Result {
+	case 11:
+		b.WriteString(", 11 is written 0")
+	case 10:
+		b.WriteString(", 10 is written K")
+	}
+	b.WriteString("\ncheck digit " + string(t.DV))
+	return b.String()
+}
@@ -0,0 +1,65 @@
+package rut
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestCalculateDVTrace(t *testing.T) {
+	got := CalculateDVTrace(12345678)
+	want := DVTrace{
+		Number: 12345678,
+		Steps: []DVStep{
+			{8, 2, 16, 16},
+			{7, 3, 21, 37},
+			{6, 4, 24, 61},
+			{5, 5, 25, 86},
+			{4, 6, 24, 110},
+			{3, 7, 21, 131},
+			{2, 2, 4, 135},
+			{1, 3, 3, 138},
+		},
+		Sum:       138,
+		Remainder: 6,
+		Result:    5,
+		DV:        '5',
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CalculateDVTrace(12345678) = %+v; want %+v", got, want)
+	}
+}
+
+func TestCalculateDVTrace_MatchesCalculateDV(t *testing.T) {
+	for _, n := range []int{0, 1, 60803000, 99999999} {
+		if got, want := CalculateDVTrace(n).DV, CalculateDV(n); got != want {
+			t.Errorf("CalculateDVTrace(%d).DV = %c; want %c", n, got, want)
+		}
+	}
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		n := rng.Intn(MaxProvisionalNumber)
+		if got, want := CalculateDVTrace(n).DV, CalculateDV(n); got != want {
+			t.Errorf("CalculateDVTrace(%d).DV = %c; want %c", n, got, want)
+		}
+	}
+}
+
+func TestDVTrace_String(t *testing.T) {
+	tests := []struct {
+		number int
+		want   string
+	}{
+		{1, "1 x 2 = 2, sum 2\n2 mod 11 = 2\n11 - 2 = 9\ncheck digit 9"},
+		{0, "0 mod 11 = 0\n11 - 0 = 11, 11 is written 0\ncheck digit 0"},
+		{4, "4 x 2 = 8, sum 8\n8 mod 11 = 8\n11 - 8 = 3\ncheck digit 3"},
+		{60, "0 x 2 = 0, sum 0\n6 x 3 = 18, sum 18\n18 mod 11 = 7\n11 - 7 = 4\ncheck digit 4"},
+		{6, "6 x 2 = 12, sum 12\n12 mod 11 = 1\n11 - 1 = 10, 10 is written K\ncheck digit K"},
+	}
+
+	for _, tt := range tests {
+		if got := CalculateDVTrace(tt.number).String(); got != tt.want {
+			t.Errorf("CalculateDVTrace(%d).String() = %q; want %q", tt.number, got, tt.want)
+		}
+	}
+}
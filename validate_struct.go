@@ -0,0 +1,148 @@
+package rut
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ErrRequired is reported by ValidateStruct for an empty field tagged
+// required.
+var ErrRequired = errors.New("rut: required")
+
+// FieldError is a ValidateStruct failure for one struct field.
+type FieldError struct {
+	Field string // Path of the field, such as "Emisor" or "Items[2].RUT"
+	Err   error  // ErrRequired, ErrNotPerson, a parse error, ...
+}
+
+func (e *FieldError) Error() string {
+	return e.Field + ": " + e.Err.Error()
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// ValidateStruct checks the fields of the struct pointed to by v, or of the
+// struct v, that have a rut tag. The field may be a RUT, a *RUT or a string
+// holding a RUT in any format supported by Parse. The comma separated tag
+// options are:
+//
+//	required  the field must not be empty
+//	persona   the RUT must belong to a natural person, see IsPerson
+//	empresa   the RUT must belong to a company, see IsCompany
+//
+// For example:
+//
+//	type Invoice struct {
+//		Emisor   rut.RUT `rut:"required,empresa"`
+//		Receptor string  `rut:"required"`
+//		Contact  *rut.RUT
+//	}
+//
+// Empty fields without required are not checked, and non-empty fields must
+// hold a valid RUT. Nested structs, pointers to structs and slices of them
+// are walked. All failures are returned joined with errors.Join, each one
+// as a *FieldError.
+func ValidateStruct(v any) error {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return fmt.Errorf("rut: ValidateStruct of %T, want a struct", v)
+	}
+
+	var errs []error
+	validateStruct(rv, "", &errs)
+	return errors.Join(errs...)
+}
+
+func validateStruct(rv reflect.Value, prefix string, errs *[]error) {
+	t := rv.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name := prefix + f.Name
+		fv := rv.Field(i)
+
+		if tag, ok := f.Tag.Lookup("rut"); ok {
+			if err := validateField(fv, tag); err != nil {
+				*errs = append(*errs, &FieldError{Field: name, Err: err})
+			}
+			continue
+		}
+		validateNested(fv, name, errs)
+	}
+}
+
+// validateNested walks untagged struct, pointer and slice fields.
+func validateNested(fv reflect.Value, name string, errs *[]error) {
+	switch fv.Kind() {
+	case reflect.Pointer:
+		if !fv.IsNil() {
+			validateNested(fv.Elem(), name, errs)
+		}
+	case reflect.Struct:
+		if fv.Type() != rutType {
+			validateStruct(fv, name+".", errs)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < fv.Len(); i++ {
+			validateNested(fv.Index(i), fmt.Sprintf("%s[%d]", name, i), errs)
+		}
+	}
+}
+
+func validateField(fv reflect.Value, tag string) error {
+	var required, person, company bool
+	for _, opt := range strings.Split(tag, ",") {
+		switch strings.TrimSpace(opt) {
+		case "":
+		case "required":
+			required = true
+		case "persona":
+			person = true
+		case "empresa":
+			company = true
+		default:
+			return fmt.Errorf("rut: unknown tag option %q", opt)
+		}
+	}
+
+	var r RUT
+	switch {
+	case fv.Type() == rutType:
+		r = fv.Interface().(RUT)
+	case fv.Kind() == reflect.Pointer && fv.Type().Elem() == rutType:
+		if !fv.IsNil() {
+			r = fv.Elem().Interface().(RUT)
+		}
+	case fv.Kind() == reflect.String:
+		if s := fv.String(); s != "" {
+			if err := r.UnmarshalText([]byte(s)); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("rut: tag on field of type %s", fv.Type())
+	}
+
+	switch {
+	case r.IsZero() && required:
+		return ErrRequired
+	case r.IsZero():
+		return nil
+	case !r.Validate():
+		return ErrInvalidDV
+	case person && !r.IsPerson():
+		return ErrNotPerson
+	case company && !r.IsCompany():
+		return ErrNotCompany
+	}
+	return nil
+}
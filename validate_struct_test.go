@@ -0,0 +1,76 @@
+package rut
+
+import (
+	"errors"
+	"testing"
+)
+
+type testInvoice struct {
+	Emisor   RUT    `rut:"required,empresa"`
+	Receptor string `rut:"required"`
+	Contact  *RUT   `rut:"persona"`
+	Items    []testItem
+	Notes    string
+}
+
+type testItem struct {
+	Proveedor RUT `rut:"required"`
+}
+
+func TestValidateStruct(t *testing.T) {
+	person := MustParse("12.345.678-5")
+	company := MustParse("60.803.000-K")
+
+	valid := testInvoice{
+		Emisor:   company,
+		Receptor: "12345678-5",
+		Contact:  &person,
+		Items:    []testItem{{Proveedor: company}},
+	}
+	if err := ValidateStruct(&valid); err != nil {
+		t.Errorf("ValidateStruct(valid) = %v; want nil", err)
+	}
+
+	invalid := testInvoice{
+		Emisor:   person,
+		Receptor: "12345678-0",
+		Contact:  &company,
+		Items:    []testItem{{Proveedor: company}, {}},
+	}
+	want := []FieldError{
+		{"Emisor", ErrNotCompany},
+		{"Receptor", ErrInvalidDV},
+		{"Contact", ErrNotPerson},
+		{"Items[1].Proveedor", ErrRequired},
+	}
+
+	err := ValidateStruct(invalid)
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		t.Fatalf("ValidateStruct(invalid) = %v; want joined errors", err)
+	}
+	errs := joined.Unwrap()
+	if len(errs) != len(want) {
+		t.Fatalf("ValidateStruct(invalid) = %v; want %d errors", err, len(want))
+	}
+	for i, e := range errs {
+		var fe *FieldError
+		if !errors.As(e, &fe) || fe.Field != want[i].Field || !errors.Is(fe, want[i].Err) {
+			t.Errorf("error %d = %v; want %s: %v", i, e, want[i].Field, want[i].Err)
+		}
+	}
+}
+
+func TestValidateStruct_BadInput(t *testing.T) {
+	if err := ValidateStruct(42); err == nil {
+		t.Errorf("ValidateStruct(42) = nil; want an error")
+	}
+
+	var bad struct {
+		N int `rut:"required"`
+		R RUT `rut:"adult"`
+	}
+	if err := ValidateStruct(bad); err == nil {
+		t.Errorf("ValidateStruct(bad tags) = nil; want an error")
+	}
+}
@@ -0,0 +1,95 @@
+package rut
+
+import "strings"
+
+var (
+	unitWords = [...]string{
+		"cero", "uno", "dos", "tres", "cuatro", "cinco", "seis", "siete", "ocho", "nueve",
+		"diez", "once", "doce", "trece", "catorce", "quince", "dieciséis", "diecisiete", "dieciocho", "diecinueve",
+		"veinte", "veintiuno", "veintidós", "veintitrés", "veinticuatro", "veinticinco", "veintiséis", "veintisiete", "veintiocho", "veintinueve",
+	}
+	tensWords = [...]string{
+		3: "treinta", 4: "cuarenta", 5: "cincuenta", 6: "sesenta", 7: "setenta", 8: "ochenta", 9: "noventa",
+	}
+	hundredsWords = [...]string{
+		1: "ciento", 2: "doscientos", 3: "trescientos", 4: "cuatrocientos", 5: "quinientos",
+		6: "seiscientos", 7: "setecientos", 8: "ochocientos", 9: "novecientos",
+	}
+)
+
+// Words returns the RUT spelled out in Spanish, as required in notarial
+// deeds and contracts:
+//
+//	rut.MustParse("12.345.678-5").Words()
+//	// "doce millones trescientos cuarenta y cinco mil seiscientos setenta y ocho guion cinco"
+//
+// A 'K' check digit is spelled "ka". Numbers of a thousand million or more
+// are read as "mil millones", the long scale used in Chile. The zero RUT
+// returns an empty string and negative numbers are spelled as their
+// absolute value.
+func (r RUT) Words() string {
+	if r.IsZero() {
+		return ""
+	}
+	dv := "ka"
+	if r.DV >= '0' && r.DV <= '9' {
+		dv = unitWords[r.DV-'0']
+	}
+	n := uint64(r.Number)
+	if r.Number < 0 {
+		n = -n
+	}
+	return numberWords(n, false) + " guion " + dv
+}
+
+// numberWords spells n in Spanish. With apocope, a final "uno" becomes
+// "un", as it must before "mil" and "millones".
+func numberWords(n uint64, apocope bool) string {
+	switch {
+	case n >= 1_000_000:
+		var s string
+		if n/1_000_000 == 1 {
+			s = "un millón"
+		} else {
+			s = numberWords(n/1_000_000, true) + " millones"
+		}
+		if rest := n % 1_000_000; rest != 0 {
+			s += " " + numberWords(rest, apocope)
+		}
+		return s
+	case n >= 1000:
+		s := "mil"
+		if n/1000 > 1 {
+			s = numberWords(n/1000, true) + " mil"
+		}
+		if rest := n % 1000; rest != 0 {
+			s += " " + numberWords(rest, apocope)
+		}
+		return s
+	case n == 100:
+		return "cien"
+	case n > 100:
+		s := hundredsWords[n/100]
+		if rest := n % 100; rest != 0 {
+			s += " " + numberWords(rest, apocope)
+		}
+		return s
+	}
+
+	var s string
+	if n < uint64(len(unitWords)) {
+		s = unitWords[n]
+	} else {
+		s = tensWords[n/10]
+		if n%10 != 0 {
+			s += " y " + unitWords[n%10]
+		}
+	}
+	if apocope && strings.HasSuffix(s, "uno") {
+		s = strings.TrimSuffix(s, "uno") + "un"
+		if s == "veintiun" {
+			s = "veintiún"
+		}
+	}
+	return s
+}
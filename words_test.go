@@ -0,0 +1,38 @@
+package rut
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestRUT_Words(t *testing.T) {
+	tests := []struct {
+		r    RUT
+		want string
+	}{
+		{RUT{Number: 12_345_678, DV: '5'}, "doce millones trescientos cuarenta y cinco mil seiscientos setenta y ocho guion cinco"},
+		{RUT{Number: 60_803_000, DV: 'K'}, "sesenta millones ochocientos tres mil guion ka"},
+		{RUT{Number: 1, DV: '9'}, "uno guion nueve"},
+		{RUT{Number: 1_000_000, DV: '0'}, "un millón guion cero"},
+		{RUT{Number: 21_021_021, DV: '0'}, "veintiún millones veintiún mil veintiuno guion cero"},
+		{RUT{Number: 31_100_101, DV: '0'}, "treinta y un millones cien mil ciento uno guion cero"},
+		{RUT{Number: 16_516_016, DV: '0'}, "dieciséis millones quinientos dieciséis mil dieciséis guion cero"},
+		{RUT{Number: 1_001_000, DV: '0'}, "un millón mil guion cero"},
+		{RUT{Number: 100_000_000, DV: '0'}, "cien millones guion cero"},
+		{RUT{Number: 1_000_000_000, DV: '0'}, "mil millones guion cero"},
+		{RUT{Number: 999_999_999, DV: '0'}, "novecientos noventa y nueve millones novecientos noventa y nueve mil novecientos noventa y nueve guion cero"},
+		{RUT{}, ""},
+		{RUT{Number: -1, DV: '9'}, "uno guion nueve"},
+		{RUT{Number: -1_009, DV: 'K'}, "mil nueve guion ka"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.r.Words(); got != tt.want {
+			t.Errorf("%v.Words() = %q; want %q", tt.r, got, tt.want)
+		}
+	}
+	if got := (RUT{Number: math.MinInt, DV: '0'}).Words(); !strings.HasSuffix(got, " guion cero") {
+		t.Errorf("RUT{Number: math.MinInt}.Words() = %q; want the absolute value spelled out", got)
+	}
+}
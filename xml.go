@@ -0,0 +1,39 @@
+package rut
+
+import "encoding/xml"
+
+// MarshalXML implements xml.Marshaler. It emits the FormatWithDash form
+// ("12345678-5") required by the SII for RUTs in DTE documents, instead of
+// the FormatComplete form used by MarshalText. The zero RUT is encoded as
+// an empty element.
+func (r RUT) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.EncodeElement(r.xmlText(), start)
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr using FormatWithDash.
+func (r RUT) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: r.xmlText()}, nil
+}
+
+func (r RUT) xmlText() string {
+	if r.IsZero() {
+		return ""
+	}
+	return r.Format(FormatWithDash)
+}
+
+// UnmarshalXML implements xml.Unmarshaler. It accepts any format supported
+// by Parse, surrounded by whitespace or non-breaking spaces as found in
+// real invoices, and returns ErrInvalidDV if the check digit does not match.
+func (r *RUT) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var s string
+	if err := d.DecodeElement(&s, &start); err != nil {
+		return err
+	}
+	return r.UnmarshalText([]byte(normalizeSeparators(s)))
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr, see UnmarshalXML.
+func (r *RUT) UnmarshalXMLAttr(attr xml.Attr) error {
+	return r.UnmarshalText([]byte(normalizeSeparators(attr.Value)))
+}
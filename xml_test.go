@@ -0,0 +1,56 @@
+package rut
+
+import (
+	"encoding/xml"
+	"errors"
+	"testing"
+)
+
+type dteEmisor struct {
+	XMLName   xml.Name `xml:"Emisor"`
+	RUTEmisor RUT      `xml:"RUTEmisor"`
+	Receptor  RUT      `xml:"receptor,attr"`
+}
+
+func TestRUT_MarshalXML(t *testing.T) {
+	v := dteEmisor{RUTEmisor: MustParse("60.803.000-K"), Receptor: MustParse("12.345.678-5")}
+	want := `<Emisor receptor="12345678-5"><RUTEmisor>60803000-K</RUTEmisor></Emisor>`
+
+	got, err := xml.Marshal(v)
+	if err != nil {
+		t.Fatalf("xml.Marshal() error = %v", err)
+	}
+	if string(got) != want {
+		t.Errorf("xml.Marshal() = %s; want %s", got, want)
+	}
+
+	want = `<Emisor receptor=""><RUTEmisor></RUTEmisor></Emisor>`
+	if got, err := xml.Marshal(dteEmisor{}); err != nil || string(got) != want {
+		t.Errorf("xml.Marshal(zero) = %s, %v; want %s", got, err, want)
+	}
+}
+
+func TestRUT_UnmarshalXML(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    RUT
+		wantErr error
+	}{
+		{`<Emisor receptor="12345678-5"><RUTEmisor>60803000-K</RUTEmisor></Emisor>`, MustParse("60803000-K"), nil},
+		{`<Emisor receptor="12.345.678-5"><RUTEmisor> 60.803.000-k&#xA0;</RUTEmisor></Emisor>`, MustParse("60803000-K"), nil},
+		{`<Emisor receptor="12345678-5"><RUTEmisor>60803000-1</RUTEmisor></Emisor>`, RUT{}, ErrInvalidDV},
+		{`<Emisor receptor="1234"><RUTEmisor>60803000-K</RUTEmisor></Emisor>`, RUT{}, ErrTooShort},
+	}
+
+	for _, tt := range tests {
+		var got dteEmisor
+		err := xml.Unmarshal([]byte(tt.input), &got)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("xml.Unmarshal(%s) error = %v; want %v", tt.input, err, tt.wantErr)
+			continue
+		}
+		if err == nil && got.RUTEmisor != tt.want {
+			t.Errorf("xml.Unmarshal(%s) = %v; want %v", tt.input, got.RUTEmisor, tt.want)
+		}
+	}
+}